@@ -291,6 +291,34 @@ func (s *AccountService) Trophies(ctx context.Context) ([]*Trophy, *Response, er
 	return trophies, resp, nil
 }
 
+// SavedCategories returns the categories your saved posts and comments are organized in.
+// Saved categories are only available to Reddit Premium users.
+func (s *AccountService) SavedCategories(ctx context.Context) ([]string, *Response, error) {
+	path := "api/saved_categories"
+
+	req, err := s.client.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(struct {
+		Categories []struct {
+			Category string `json:"category"`
+		} `json:"categories"`
+	})
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	categories := make([]string, 0, len(root.Categories))
+	for _, c := range root.Categories {
+		categories = append(categories, c.Category)
+	}
+
+	return categories, resp, nil
+}
+
 // Friends returns a list of your friends.
 func (s *AccountService) Friends(ctx context.Context) ([]Relationship, *Response, error) {
 	path := "prefs/friends"
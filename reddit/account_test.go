@@ -259,6 +259,19 @@ func TestAccountService_Messaging(t *testing.T) {
 	require.Equal(t, expectedRelationships2, trusted)
 }
 
+func TestAccountService_SavedCategories(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/api/saved_categories", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, `{"categories": [{"category": "recipes"}, {"category": "golang"}]}`)
+	})
+
+	categories, _, err := client.Account.SavedCategories(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []string{"recipes", "golang"}, categories)
+}
+
 func TestAccountService_Trusted(t *testing.T) {
 	client, mux := setup(t)
 
@@ -3,9 +3,9 @@ package reddit
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/url"
-	"strings"
 )
 
 // CommentService handles communication with the comment
@@ -66,6 +66,40 @@ func (s *CommentService) Edit(ctx context.Context, id string, text string) (*Com
 	return root, resp, nil
 }
 
+// GetWithContext gets a comment of a post, along with its parent comments, up to contextDepth levels above it.
+// postID and commentID are the ID36s of the post and comment, not their full IDs.
+// contextDepth must be between 0 and 8. The returned tree is rooted at the top-most parent
+// that was retrieved, and leads down to the comment and its replies.
+func (s *CommentService) GetWithContext(ctx context.Context, postID, commentID string, contextDepth int) (*PostAndComments, *Response, error) {
+	if contextDepth < 0 || contextDepth > 8 {
+		return nil, nil, errors.New("contextDepth: must be between 0 and 8")
+	}
+
+	path := fmt.Sprintf("comments/%s", postID)
+	params := struct {
+		Comment string `url:"comment"`
+		Context int    `url:"context"`
+	}{commentID, contextDepth}
+
+	path, err := addOptions(path, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(PostAndComments)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
 // LoadMoreReplies retrieves more replies that were left out when initially fetching the comment.
 func (s *CommentService) LoadMoreReplies(ctx context.Context, comment *Comment) (*Response, error) {
 	if comment == nil {
@@ -79,35 +113,11 @@ func (s *CommentService) LoadMoreReplies(ctx context.Context, comment *Comment)
 	postID := comment.PostID
 	commentIDs := comment.Replies.More.Children
 
-	form := url.Values{}
-	form.Set("api_type", "json")
-	form.Set("link_id", postID)
-	form.Set("children", strings.Join(commentIDs, ","))
-
-	path := "api/morechildren"
-
-	// This was originally a GET, but with POST you can send a bigger payload
-	// since it's in the body and not the URI.
-	req, err := s.client.NewRequest(http.MethodPost, path, form)
-	if err != nil {
-		return nil, err
-	}
-
-	root := new(struct {
-		JSON struct {
-			Data struct {
-				Things things `json:"things"`
-			} `json:"data"`
-		} `json:"json"`
-	})
-	resp, err := s.client.Do(ctx, req, root)
+	comments, mores, resp, err := s.moreChildren(ctx, postID, commentIDs)
 	if err != nil {
 		return resp, err
 	}
 
-	comments := root.JSON.Data.Things.Comments
-	mores := root.JSON.Data.Things.Mores
-
 	for _, c := range comments {
 		comment.addCommentToReplies(c)
 	}
@@ -120,3 +130,54 @@ func (s *CommentService) LoadMoreReplies(ctx context.Context, comment *Comment)
 
 	return resp, nil
 }
+
+// LoadMore retrieves the comments of a "more" node found anywhere in the post's comment tree.
+// The retrieved comments (and any "more" nodes nested within them) are added to the tree under
+// their respective parents, and the node is removed from the tree once all of its children are loaded.
+// The children are loaded in batches of 100; if a request fails, the node keeps the children
+// that have not been loaded yet.
+// A node without children, i.e. a "continue this thread" link, is left as is, and (nil, nil) is returned.
+// The returned *Response is the one of the last request made.
+func (s *CommentService) LoadMore(ctx context.Context, pc *PostAndComments, more *More) (*Response, error) {
+	if pc == nil {
+		return nil, errors.New("*PostAndComments: cannot be nil")
+	}
+	if more == nil {
+		return nil, errors.New("*More: cannot be nil")
+	}
+
+	if len(more.Children) == 0 {
+		return nil, nil
+	}
+
+	var resp *Response
+	for len(more.Children) > 0 {
+		end := maxMoreChildren
+		if end > len(more.Children) {
+			end = len(more.Children)
+		}
+
+		comments, mores, r, err := s.moreChildren(ctx, pc.Post.FullID, more.Children[:end])
+		resp = r
+		if err != nil {
+			return resp, err
+		}
+		more.Children = more.Children[end:]
+
+		for _, c := range comments {
+			pc.addCommentToTree(c)
+		}
+		for _, m := range mores {
+			// the node is still in the tree until all of its children are loaded
+			if m.ParentID == more.ParentID && len(more.Children) > 0 {
+				more.Children = append(more.Children, m.Children...)
+				continue
+			}
+			pc.addMoreToTree(m)
+		}
+	}
+
+	pc.removeMoreFromTree(more)
+
+	return resp, nil
+}
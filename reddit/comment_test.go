@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strings"
 	"testing"
 	"time"
 
@@ -21,6 +22,9 @@ var expectedCommentSubmitOrEdit = &Comment{
 	AuthorID:        "t2_user1",
 	AuthorFlairText: "Flair",
 	AuthorFlairID:   "024b2b66-05ca-11e1-96f4-12313d096aae",
+	AuthorFlairRichText: FlairRichText{
+		{Type: "text", Text: "Beginner - Strength"},
+	},
 
 	SubredditName:         "subreddit",
 	SubredditNamePrefixed: "r/subreddit",
@@ -32,7 +36,7 @@ var expectedCommentSubmitOrEdit = &Comment{
 	Controversiality: 0,
 
 	Created: &Timestamp{time.Date(2020, 4, 29, 0, 9, 47, 0, time.UTC)},
-	Edited:  &Timestamp{time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)},
+	Edited:  &Edited{},
 
 	PostID: "t3_link1",
 }
@@ -289,6 +293,34 @@ func TestCommentService_RemoveVote(t *testing.T) {
 	require.Equal(t, http.StatusOK, resp.StatusCode)
 }
 
+func TestCommentService_GetWithContext(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/post/post.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/comments/abc123", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		form := url.Values{}
+		form.Set("comment", "testc2")
+		form.Set("context", "1")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.Form)
+
+		fmt.Fprint(w, blob)
+	})
+
+	_, _, err = client.Comment.GetWithContext(ctx, "abc123", "testc2", 9)
+	require.EqualError(t, err, "contextDepth: must be between 0 and 8")
+
+	postAndComments, _, err := client.Comment.GetWithContext(ctx, "abc123", "testc2", 1)
+	require.NoError(t, err)
+	require.Equal(t, expectedPostAndComments, postAndComments)
+}
+
 func TestCommentService_LoadMoreReplies(t *testing.T) {
 	client, mux := setup(t)
 
@@ -334,6 +366,123 @@ func TestCommentService_LoadMoreReplies(t *testing.T) {
 	require.Len(t, comment.Replies.Comments[0].Replies.Comments, 1)
 }
 
+func TestCommentService_LoadMore(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/comment/more.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/api/morechildren", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("link_id", "t3_123")
+		form.Set("children", "def,ghi,jkl")
+		form.Set("api_type", "json")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+
+		fmt.Fprint(w, blob)
+	})
+
+	more := &More{
+		ParentID: "t1_abc",
+		Children: []string{"def", "ghi", "jkl"},
+	}
+	pc := &PostAndComments{
+		Post: &Post{FullID: "t3_123"},
+		Comments: []*Comment{
+			{FullID: "t1_xyz", ParentID: "t3_123"},
+			{
+				FullID:   "t1_uvw",
+				ParentID: "t3_123",
+				Replies: Replies{
+					Comments: []*Comment{
+						{FullID: "t1_abc", ParentID: "t1_uvw", Replies: Replies{More: more}},
+					},
+				},
+			},
+		},
+	}
+
+	_, err = client.Comment.LoadMore(ctx, nil, more)
+	require.EqualError(t, err, "*PostAndComments: cannot be nil")
+
+	_, err = client.Comment.LoadMore(ctx, pc, nil)
+	require.EqualError(t, err, "*More: cannot be nil")
+
+	resp, err := client.Comment.LoadMore(ctx, pc, &More{})
+	require.Nil(t, resp)
+	require.Nil(t, err)
+
+	_, err = client.Comment.LoadMore(ctx, pc, more)
+	require.NoError(t, err)
+
+	comment := pc.Comments[1].Replies.Comments[0]
+	require.False(t, comment.HasMore())
+	require.Len(t, comment.Replies.Comments, 2)
+	require.Equal(t, "t1_def", comment.Replies.Comments[0].FullID)
+	require.Equal(t, "t1_ghi", comment.Replies.Comments[1].FullID)
+	require.Len(t, comment.Replies.Comments[0].Replies.Comments, 1)
+	require.Equal(t, "t1_jkl", comment.Replies.Comments[0].Replies.Comments[0].FullID)
+}
+
+func TestCommentService_LoadMore_Batches(t *testing.T) {
+	client, mux := setup(t)
+
+	children := make([]string, 150)
+	for i := range children {
+		children[i] = fmt.Sprintf("c%d", i)
+	}
+
+	var requests []string
+	fail := true
+	mux.HandleFunc("/api/morechildren", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+
+		ids := strings.Split(r.PostForm.Get("children"), ",")
+		requests = append(requests, r.PostForm.Get("children"))
+
+		if fail && len(requests) == 2 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		things := make([]string, 0, len(ids))
+		for _, id := range ids {
+			things = append(things, fmt.Sprintf(`{"kind": "t1", "data": {"id": "%s", "name": "t1_%s", "parent_id": "t1_abc", "replies": ""}}`, id, id))
+		}
+		fmt.Fprintf(w, `{"json": {"data": {"things": [%s]}}}`, strings.Join(things, ","))
+	})
+
+	more := &More{ParentID: "t1_abc", Children: children}
+	comment := &Comment{FullID: "t1_abc", ParentID: "t3_123", Replies: Replies{More: more}}
+	pc := &PostAndComments{
+		Post:     &Post{FullID: "t3_123"},
+		Comments: []*Comment{comment},
+	}
+
+	_, err := client.Comment.LoadMore(ctx, pc, more)
+	require.IsType(t, &ErrorResponse{}, err)
+	require.Equal(t, []string{strings.Join(children[:100], ","), strings.Join(children[100:], ",")}, requests)
+	require.Len(t, comment.Replies.Comments, 100)
+	require.True(t, comment.HasMore())
+	require.Equal(t, children[100:], more.Children)
+
+	requests = nil
+	fail = false
+	_, err = client.Comment.LoadMore(ctx, pc, more)
+	require.NoError(t, err)
+	require.Equal(t, []string{strings.Join(children[100:], ",")}, requests)
+	require.Len(t, comment.Replies.Comments, 150)
+	require.False(t, comment.HasMore())
+}
+
 func TestCommentService_Report(t *testing.T) {
 	client, mux := setup(t)
 
@@ -353,3 +502,9 @@ func TestCommentService_Report(t *testing.T) {
 	_, err := client.Comment.Report(ctx, "t1_test", "test reason")
 	require.NoError(t, err)
 }
+
+func TestComment_Vote(t *testing.T) {
+	require.Equal(t, VoteNone, (&Comment{}).Vote())
+	require.Equal(t, VoteUp, (&Comment{Likes: Bool(true)}).Vote())
+	require.Equal(t, VoteDown, (&Comment{Likes: Bool(false)}).Vote())
+}
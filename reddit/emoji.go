@@ -1,21 +1,16 @@
 package reddit
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
-	"mime/multipart"
 	"net/http"
 	"net/url"
-	"os"
 	"strconv"
 	"strings"
 
 	"github.com/google/go-querystring/query"
-	"golang.org/x/net/context/ctxhttp"
 )
 
 // EmojiService handles communication with the emoji
@@ -212,44 +207,9 @@ func (s *EmojiService) Upload(ctx context.Context, subreddit string, createReque
 		return resp, err
 	}
 
-	file, err := os.Open(imagePath)
+	resp, err = uploadToS3(ctx, uploadURL, fields, imagePath)
 	if err != nil {
-		return nil, err
-	}
-	defer file.Close()
-
-	body := new(bytes.Buffer)
-	writer := multipart.NewWriter(body)
-
-	// AWS ignores all fields in the request that come after the file field, so we need to set these before
-	// https://stackoverflow.com/questions/15234496/upload-directly-to-amazon-s3-using-ajax-returning-error-bucket-post-must-contai/15235866#15235866
-	for k, v := range fields {
-		writer.WriteField(k, v)
-	}
-
-	part, err := writer.CreateFormFile("file", file.Name())
-	if err != nil {
-		return nil, err
-	}
-
-	_, err = io.Copy(part, file)
-	if err != nil {
-		return nil, err
-	}
-
-	err = writer.Close()
-	if err != nil {
-		return nil, err
-	}
-
-	httpResponse, err := ctxhttp.Post(ctx, nil, uploadURL, writer.FormDataContentType(), body)
-	if err != nil {
-		return nil, err
-	}
-
-	err = CheckResponse(httpResponse)
-	if err != nil {
-		return newResponse(httpResponse), err
+		return resp, err
 	}
 
 	return s.upload(ctx, subreddit, createRequest, fields["key"])
@@ -57,6 +57,24 @@ func (r *JSONErrorResponse) Error() string {
 	)
 }
 
+func (r *JSONErrorResponse) hasLabel(label string) bool {
+	for _, err := range r.JSON.Errors {
+		if err.Label == label {
+			return true
+		}
+	}
+	return false
+}
+
+// AlreadySubmittedError occurs when submitting a link that has already been submitted
+// to the subreddit, without allowing it to be resubmitted.
+type AlreadySubmittedError struct {
+	*JSONErrorResponse
+
+	// The existing submissions of the link in the subreddit.
+	Posts []*Post
+}
+
 // An ErrorResponse reports the error caused by an API request
 type ErrorResponse struct {
 	// HTTP response that caused this error
@@ -64,6 +82,9 @@ type ErrorResponse struct {
 
 	// Error message
 	Message string `json:"message"`
+	// Some endpoints return a code identifying the error, along with an explanation.
+	Reason      string `json:"reason,omitempty"`
+	Explanation string `json:"explanation,omitempty"`
 }
 
 func (r *ErrorResponse) Error() string {
@@ -73,6 +94,68 @@ func (r *ErrorResponse) Error() string {
 	)
 }
 
+// InsufficientCoinsError occurs when gilding or awarding a post or comment
+// without owning enough Reddit coins.
+type InsufficientCoinsError struct {
+	*ErrorResponse
+}
+
+// asInsufficientCoinsError returns an *InsufficientCoinsError if the error
+// was caused by a lack of coins, otherwise it returns the error unchanged.
+func asInsufficientCoinsError(err error) error {
+	if errResp, ok := err.(*ErrorResponse); ok && strings.HasPrefix(errResp.Reason, "INSUFFICIENT_COINS") {
+		return &InsufficientCoinsError{errResp}
+	}
+	return err
+}
+
+// NoStickyError occurs when getting a stickied post from
+// a subreddit that doesn't have one in the requested slot.
+type NoStickyError struct {
+	*ErrorResponse
+}
+
+// asNoStickyError returns a *NoStickyError if the error was
+// a 404 response, otherwise it returns the error unchanged.
+func asNoStickyError(err error) error {
+	if errResp, ok := err.(*ErrorResponse); ok && errResp.Response.StatusCode == http.StatusNotFound {
+		return &NoStickyError{errResp}
+	}
+	return err
+}
+
+// QuarantinedError occurs when accessing a quarantined subreddit
+// without having opted in to view its content.
+// To opt in, use SubredditService.OptInQuarantine.
+type QuarantinedError struct {
+	*ErrorResponse
+
+	// Reddit's explanation of why the subreddit is quarantined.
+	QuarantineMessage string `json:"quarantine_message,omitempty"`
+}
+
+// WikiEditConflictError occurs when editing a wiki page based on a revision
+// that is no longer the latest one, i.e. the page was edited in the meantime.
+type WikiEditConflictError struct {
+	*ErrorResponse
+
+	// The current content of the page.
+	NewContent string `json:"newcontent,omitempty"`
+	// The ID of the current revision of the page.
+	NewRevisionID string `json:"newrevision,omitempty"`
+	// The differences between the submitted content and the current one, as HTML.
+	Diff string `json:"diffcontent,omitempty"`
+}
+
+// WikiSpecialPageError occurs when Reddit rejects the content of a special
+// wiki page, such as config/automoderator, e.g. because of a syntax error.
+type WikiSpecialPageError struct {
+	*ErrorResponse
+
+	// The problems found with the content.
+	Errors []string `json:"special_errors,omitempty"`
+}
+
 // RateLimitError occurs when the client is sending too many requests to Reddit in a given time frame.
 type RateLimitError struct {
 	// Rate specifies the last known rate limit for the client
@@ -4,10 +4,13 @@ import (
 	"bytes"
 	"context"
 	"encoding/csv"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/google/go-querystring/query"
 )
@@ -32,6 +35,79 @@ type Flair struct {
 
 	Editable bool `json:"text_editable"`
 	ModOnly  bool `json:"mod_only"`
+
+	// One of: all, emoji, text.
+	AllowableContent string        `json:"allowable_content,omitempty"`
+	MaxEmojis        int           `json:"max_emojis"`
+	RichText         FlairRichText `json:"richtext,omitempty"`
+}
+
+// FlairRichText is the rich text representation of a flair, made up of text and emoji segments.
+// Segments can be chained to build one, e.g.
+//
+//	FlairRichText{}.Emoji("star").Text(" Helper")
+type FlairRichText []*FlairRichTextSegment
+
+// FlairRichTextSegment is a segment of a rich text flair.
+type FlairRichTextSegment struct {
+	// One of: text, emoji.
+	Type string `json:"e"`
+	// Only set for text segments.
+	Text string `json:"t,omitempty"`
+	// The name of the emoji, surrounded by colons, e.g. :star:.
+	// Only set for emoji segments.
+	Emoji string `json:"a,omitempty"`
+	// The URL of the emoji's image. Only set for emoji segments.
+	URL string `json:"u,omitempty"`
+}
+
+// Text returns a copy of the rich text with a text segment appended to it.
+func (r FlairRichText) Text(text string) FlairRichText {
+	// limit the capacity so that append never writes into the caller's backing array
+	return append(r[:len(r):len(r)], &FlairRichTextSegment{Type: "text", Text: text})
+}
+
+// Emoji returns a copy of the rich text with an emoji segment appended to it.
+// The emoji must be one of the subreddit's emojis.
+func (r FlairRichText) Emoji(name string) FlairRichText {
+	name = ":" + strings.Trim(name, ":") + ":"
+	return append(r[:len(r):len(r)], &FlairRichTextSegment{Type: "emoji", Emoji: name})
+}
+
+// String returns the rich text in its text form, where emojis are represented by their
+// names surrounded by colons, e.g. ":star: Helper". This is what Reddit expects as the
+// text of flair templates and flair selections; it is converted to rich text on their end
+// if the template allows emojis.
+func (r FlairRichText) String() string {
+	var sb strings.Builder
+	for _, segment := range r {
+		if segment == nil {
+			continue
+		}
+		switch segment.Type {
+		case "emoji":
+			sb.WriteString(segment.Emoji)
+		default:
+			sb.WriteString(segment.Text)
+		}
+	}
+	return sb.String()
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// Empty rich text is decoded as nil.
+func (r *FlairRichText) UnmarshalJSON(data []byte) error {
+	var segments []*FlairRichTextSegment
+	err := json.Unmarshal(data, &segments)
+	if err != nil {
+		return err
+	}
+
+	if len(segments) == 0 {
+		segments = nil
+	}
+	*r = segments
+	return nil
 }
 
 // FlairSummary is a condensed version of Flair.
@@ -76,6 +152,7 @@ type FlairTemplateCreateOrUpdateRequest struct {
 	// One of: all, emoji, text.
 	AllowableContent string `url:"allowable_content,omitempty"`
 	// No longer than 64 characters.
+	// To include emojis, use the String method of a FlairRichText.
 	Text string `url:"text,omitempty"`
 	// One of: light, dark.
 	TextColor string `url:"text_color,omitempty"`
@@ -100,12 +177,12 @@ type FlairTemplate struct {
 	Type    string `json:"flairType"`
 	ModOnly bool   `json:"modOnly"`
 
-	AllowableContent string              `json:"allowableContent"`
-	Text             string              `json:"text"`
-	TextType         string              `json:"type"`
-	TextColor        string              `json:"textColor"`
-	TextEditable     bool                `json:"textEditable"`
-	RichText         []map[string]string `json:"richtext"`
+	AllowableContent string        `json:"allowableContent"`
+	Text             string        `json:"text"`
+	TextType         string        `json:"type"`
+	TextColor        string        `json:"textColor"`
+	TextEditable     bool          `json:"textEditable"`
+	RichText         FlairRichText `json:"richtext"`
 
 	OverrideCSS     bool   `json:"overrideCss"`
 	MaxEmojis       int    `json:"maxEmojis"`
@@ -119,6 +196,7 @@ type FlairSelectRequest struct {
 	ID string `url:"flair_template_id,omitempty"`
 	// No longer than 64 characters.
 	// Only use this if the flair is editable (it is by default if you're a mod of the subreddit).
+	// To include emojis, use the String method of a FlairRichText.
 	Text string `url:"text,omitempty"`
 }
 
@@ -476,6 +554,38 @@ func (s *FlairService) Assign(ctx context.Context, subreddit, user string, reque
 	return s.client.Do(ctx, req, nil)
 }
 
+// AssignCustom assigns a flair that isn't based on a template to another user in the subreddit.
+// The text can be no longer than 64 characters.
+// Unlike Change, which reports the problems of each request in its responses, a flair
+// rejected by Reddit results in an error.
+// You have to be a moderator of the subreddit for this to work.
+func (s *FlairService) AssignCustom(ctx context.Context, subreddit string, request *FlairChangeRequest) (*Response, error) {
+	if request == nil {
+		return nil, errors.New("*FlairChangeRequest: cannot be nil")
+	}
+	if request.User == "" {
+		return nil, errors.New("(*FlairChangeRequest).User: cannot be empty")
+	}
+	if utf8.RuneCountInString(request.Text) > 64 {
+		return nil, errors.New("(*FlairChangeRequest).Text: cannot be longer than 64 characters")
+	}
+
+	path := fmt.Sprintf("r/%s/api/flair", subreddit)
+
+	form := url.Values{}
+	form.Set("api_type", "json")
+	form.Set("name", request.User)
+	form.Set("text", request.Text)
+	form.Set("css_class", request.CSSClass)
+
+	req, err := s.client.NewRequest(http.MethodPost, path, form)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
 // SelectForPost assigns a flair to the post.
 // If the post isn't yours, you have to be a moderator of the post's subreddit for this to work.
 func (s *FlairService) SelectForPost(ctx context.Context, postID string, request *FlairSelectRequest) (*Response, error) {
@@ -560,3 +670,35 @@ func (s *FlairService) Change(ctx context.Context, subreddit string, requests []
 
 	return root, resp, nil
 }
+
+// ChangeAll changes the flair of any number of users in the subreddit.
+// The requests are sent in batches of 100, and the responses are returned in the same order as the requests.
+// If a batch fails, the responses of the batches that succeeded before it are returned along with the error.
+func (s *FlairService) ChangeAll(ctx context.Context, subreddit string, requests []FlairChangeRequest) ([]*FlairChangeResponse, *Response, error) {
+	if len(requests) == 0 {
+		return nil, nil, errors.New("requests: must provide at least 1")
+	}
+
+	var results []*FlairChangeResponse
+	var resp *Response
+
+	for len(requests) > 0 {
+		n := len(requests)
+		if n > maxBatchSize {
+			n = maxBatchSize
+		}
+
+		var batch []*FlairChangeResponse
+		var err error
+
+		batch, resp, err = s.Change(ctx, subreddit, requests[:n])
+		if err != nil {
+			return results, resp, err
+		}
+
+		results = append(results, batch...)
+		requests = requests[n:]
+	}
+
+	return results, resp, nil
+}
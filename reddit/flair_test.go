@@ -1,10 +1,12 @@
 package reddit
 
 import (
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -22,6 +24,9 @@ var expectedUserFlairs = []*Flair{
 
 		Editable: false,
 		ModOnly:  false,
+
+		AllowableContent: "all",
+		MaxEmojis:        10,
 	},
 	{
 		ID:   "b8ea0fce-3feb-11e8-af7a-0e263a127cf8",
@@ -34,6 +39,9 @@ var expectedUserFlairs = []*Flair{
 
 		Editable: false,
 		ModOnly:  true,
+
+		AllowableContent: "all",
+		MaxEmojis:        10,
 	},
 }
 
@@ -49,6 +57,12 @@ var expectedPostFlairs = []*Flair{
 
 		Editable: false,
 		ModOnly:  true,
+
+		AllowableContent: "all",
+		MaxEmojis:        10,
+		RichText: FlairRichText{
+			{Type: "text", Text: "test"},
+		},
 	},
 }
 
@@ -73,8 +87,8 @@ var expectedFlairTemplate = &FlairTemplate{
 	TextType:         "richtext",
 	TextColor:        "dark",
 	TextEditable:     false,
-	RichText: []map[string]string{
-		{"e": "text", "t": "lol"},
+	RichText: FlairRichText{
+		{Type: "text", Text: "lol"},
 	},
 
 	OverrideCSS:     false,
@@ -618,6 +632,40 @@ func TestFlairService_Assign(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestFlairService_AssignCustom(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/r/testsubreddit/api/flair", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("api_type", "json")
+		form.Set("name", "testuser")
+		form.Set("text", "testtext")
+		form.Set("css_class", "testclass")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+	})
+
+	_, err := client.Flair.AssignCustom(ctx, "testsubreddit", nil)
+	require.EqualError(t, err, "*FlairChangeRequest: cannot be nil")
+
+	_, err = client.Flair.AssignCustom(ctx, "testsubreddit", &FlairChangeRequest{Text: "testtext"})
+	require.EqualError(t, err, "(*FlairChangeRequest).User: cannot be empty")
+
+	_, err = client.Flair.AssignCustom(ctx, "testsubreddit", &FlairChangeRequest{User: "testuser", Text: strings.Repeat("x", 65)})
+	require.EqualError(t, err, "(*FlairChangeRequest).Text: cannot be longer than 64 characters")
+
+	_, err = client.Flair.AssignCustom(ctx, "testsubreddit", &FlairChangeRequest{
+		User:     "testuser",
+		Text:     "testtext",
+		CSSClass: "testclass",
+	})
+	require.NoError(t, err)
+}
+
 func TestFlairService_SelectForPost(t *testing.T) {
 	client, mux := setup(t)
 
@@ -699,3 +747,73 @@ testuser4,testtext4,testclass4
 	require.NoError(t, err)
 	require.Equal(t, expectedFlairChanges, changes)
 }
+
+func TestFlairService_ChangeAll(t *testing.T) {
+	client, mux := setup(t)
+
+	var counter int
+	mux.HandleFunc("/r/testsubreddit/api/flaircsv", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		counter++
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+
+		records, err := csv.NewReader(strings.NewReader(r.PostForm.Get("flair_csv"))).ReadAll()
+		require.NoError(t, err)
+
+		var results []string
+		for _, record := range records {
+			results = append(results, fmt.Sprintf(`{"ok": true, "status": "added flair for user %s"}`, record[0]))
+		}
+		fmt.Fprintf(w, "[%s]", strings.Join(results, ","))
+	})
+
+	_, _, err := client.Flair.ChangeAll(ctx, "testsubreddit", nil)
+	require.EqualError(t, err, "requests: must provide at least 1")
+
+	var requests []FlairChangeRequest
+	for i := 0; i < 150; i++ {
+		requests = append(requests, FlairChangeRequest{User: fmt.Sprintf("testuser%d", i), Text: "testtext"})
+	}
+
+	changes, _, err := client.Flair.ChangeAll(ctx, "testsubreddit", requests)
+	require.NoError(t, err)
+	require.Equal(t, 2, counter)
+	require.Len(t, changes, 150)
+	require.Equal(t, "added flair for user testuser0", changes[0].Status)
+	require.Equal(t, "added flair for user testuser149", changes[149].Status)
+}
+
+func TestFlairRichText(t *testing.T) {
+	richText := FlairRichText{}.Emoji("star").Text(" Helper ").Emoji(":snoo:")
+	require.Equal(t, FlairRichText{
+		{Type: "emoji", Emoji: ":star:"},
+		{Type: "text", Text: " Helper "},
+		{Type: "emoji", Emoji: ":snoo:"},
+	}, richText)
+	require.Equal(t, ":star: Helper :snoo:", richText.String())
+
+	base := FlairRichText{}.Text("a").Text("b").Text("c")
+	x := base.Text("x")
+	y := base.Emoji("y")
+	require.Equal(t, "abc", base.String())
+	require.Equal(t, "abcx", x.String())
+	require.Equal(t, "abc:y:", y.String())
+
+	b, err := json.Marshal(richText)
+	require.NoError(t, err)
+	require.JSONEq(t, `[{"e": "emoji", "a": ":star:"}, {"e": "text", "t": " Helper "}, {"e": "emoji", "a": ":snoo:"}]`, string(b))
+
+	var decoded FlairRichText
+	err = json.Unmarshal([]byte(`[]`), &decoded)
+	require.NoError(t, err)
+	require.Nil(t, decoded)
+
+	err = json.Unmarshal([]byte(`[{"e": "emoji", "a": ":star:", "u": "https://emoji.redditmedia.com/star.png"}, {"e": "text", "t": "Helper"}]`), &decoded)
+	require.NoError(t, err)
+	require.Equal(t, FlairRichText{
+		{Type: "emoji", Emoji: ":star:", URL: "https://emoji.redditmedia.com/star.png"},
+		{Type: "text", Text: "Helper"},
+	}, decoded)
+}
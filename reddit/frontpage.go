@@ -0,0 +1,48 @@
+package reddit
+
+import (
+	"context"
+)
+
+// FrontpageService handles communication with the listings of your
+// front page, i.e. the posts from the subreddits you're subscribed to.
+//
+// Reddit API docs: https://www.reddit.com/dev/api/#section_listings
+type FrontpageService struct {
+	client *Client
+}
+
+// Best returns the best posts from your front page.
+// This is the default feed of Reddit's official clients.
+func (s *FrontpageService) Best(ctx context.Context, opts *ListOptions) ([]*Post, *Response, error) {
+	l, resp, err := s.client.getListing(ctx, "best", opts)
+	if err != nil {
+		return nil, resp, err
+	}
+	return l.Posts(), resp, nil
+}
+
+// Hot returns the hottest posts from your front page.
+func (s *FrontpageService) Hot(ctx context.Context, opts *ListOptions) ([]*Post, *Response, error) {
+	return s.client.Subreddit.getPosts(ctx, "hot", "", opts)
+}
+
+// New returns the newest posts from your front page.
+func (s *FrontpageService) New(ctx context.Context, opts *ListOptions) ([]*Post, *Response, error) {
+	return s.client.Subreddit.getPosts(ctx, "new", "", opts)
+}
+
+// Rising returns the rising posts from your front page.
+func (s *FrontpageService) Rising(ctx context.Context, opts *ListOptions) ([]*Post, *Response, error) {
+	return s.client.Subreddit.getPosts(ctx, "rising", "", opts)
+}
+
+// Controversial returns the most controversial posts from your front page.
+func (s *FrontpageService) Controversial(ctx context.Context, opts *ListPostOptions) ([]*Post, *Response, error) {
+	return s.client.Subreddit.getPosts(ctx, "controversial", "", opts)
+}
+
+// Top returns the top posts from your front page.
+func (s *FrontpageService) Top(ctx context.Context, opts *ListPostOptions) ([]*Post, *Response, error) {
+	return s.client.Subreddit.getPosts(ctx, "top", "", opts)
+}
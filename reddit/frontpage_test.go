@@ -0,0 +1,127 @@
+package reddit
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFrontpageService_Best(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/subreddit/posts.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/best", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, "t3_test", r.Form.Get("after"))
+		require.Equal(t, "10", r.Form.Get("limit"))
+
+		fmt.Fprint(w, blob)
+	})
+
+	posts, resp, err := client.Frontpage.Best(ctx, &ListOptions{After: "t3_test", Limit: 10})
+	require.NoError(t, err)
+	require.Equal(t, expectedPosts, posts)
+	require.Equal(t, "t3_hyhquk", resp.After)
+}
+
+func TestFrontpageService_Hot(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/subreddit/posts.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/hot", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, blob)
+	})
+
+	posts, resp, err := client.Frontpage.Hot(ctx, nil)
+	require.NoError(t, err)
+	require.Equal(t, expectedPosts, posts)
+	require.Equal(t, "t3_hyhquk", resp.After)
+}
+
+func TestFrontpageService_New(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/subreddit/posts.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/new", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, blob)
+	})
+
+	posts, resp, err := client.Frontpage.New(ctx, nil)
+	require.NoError(t, err)
+	require.Equal(t, expectedPosts, posts)
+	require.Equal(t, "t3_hyhquk", resp.After)
+}
+
+func TestFrontpageService_Rising(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/subreddit/posts.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/rising", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, blob)
+	})
+
+	posts, resp, err := client.Frontpage.Rising(ctx, nil)
+	require.NoError(t, err)
+	require.Equal(t, expectedPosts, posts)
+	require.Equal(t, "t3_hyhquk", resp.After)
+}
+
+func TestFrontpageService_Controversial(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/subreddit/posts.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/controversial", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, "week", r.Form.Get("t"))
+
+		fmt.Fprint(w, blob)
+	})
+
+	posts, resp, err := client.Frontpage.Controversial(ctx, &ListPostOptions{Time: TimeFilterWeek})
+	require.NoError(t, err)
+	require.Equal(t, expectedPosts, posts)
+	require.Equal(t, "t3_hyhquk", resp.After)
+}
+
+func TestFrontpageService_Top(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/subreddit/posts.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/top", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, "all", r.Form.Get("t"))
+
+		fmt.Fprint(w, blob)
+	})
+
+	posts, resp, err := client.Frontpage.Top(ctx, &ListPostOptions{Time: TimeFilterAll})
+	require.NoError(t, err)
+	require.Equal(t, expectedPosts, posts)
+	require.Equal(t, "t3_hyhquk", resp.After)
+}
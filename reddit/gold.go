@@ -19,13 +19,38 @@ type GoldService struct {
 
 // Gild the post or comment via its full ID.
 // This requires you to own Reddit coins and will consume them.
+// If you don't own enough coins, an *InsufficientCoinsError is returned.
 func (s *GoldService) Gild(ctx context.Context, id string) (*Response, error) {
 	path := fmt.Sprintf("api/v1/gold/gild/%s", id)
 	req, err := s.client.NewRequest(http.MethodPost, path, nil)
 	if err != nil {
 		return nil, err
 	}
-	return s.client.Do(ctx, req, nil)
+
+	resp, err := s.client.Do(ctx, req, nil)
+	return resp, asInsufficientCoinsError(err)
+}
+
+// Award the post or comment via its full ID.
+// awardID is the ID of the award to give, e.g. gid_1 for Silver, or award_5f123e3d-4f48-42f4-9c11-e98b566d5897
+// for Wholesome. If anonymous is true, the award is given anonymously.
+// This requires you to own Reddit coins and will consume them.
+// If you don't own enough coins, an *InsufficientCoinsError is returned.
+func (s *GoldService) Award(ctx context.Context, id string, awardID string, anonymous bool) (*Response, error) {
+	path := "api/v2/gold/gild"
+
+	form := url.Values{}
+	form.Set("thing_id", id)
+	form.Set("gild_type", awardID)
+	form.Set("is_anonymous", strconv.FormatBool(anonymous))
+
+	req, err := s.client.NewRequest(http.MethodPost, path, form)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(ctx, req, nil)
+	return resp, asInsufficientCoinsError(err)
 }
 
 // Give the user between 1 and 36 (inclusive) months of gold.
@@ -1,6 +1,7 @@
 package reddit
 
 import (
+	"fmt"
 	"net/http"
 	"net/url"
 	"testing"
@@ -19,6 +20,45 @@ func TestGoldService_Gild(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestGoldService_Gild_InsufficientCoins(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/api/v1/gold/gild/t1_test", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{
+			"explanation": "You don't have enough coins to give this award.",
+			"message": "Bad Request",
+			"reason": "INSUFFICIENT_COINS_WITH_AMOUNT"
+		}`)
+	})
+
+	_, err := client.Gold.Gild(ctx, "t1_test")
+	require.IsType(t, &InsufficientCoinsError{}, err)
+	require.Equal(t, "You don't have enough coins to give this award.", err.(*InsufficientCoinsError).Explanation)
+}
+
+func TestGoldService_Award(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/api/v2/gold/gild", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("thing_id", "t3_test")
+		form.Set("gild_type", "gid_1")
+		form.Set("is_anonymous", "true")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+	})
+
+	_, err := client.Gold.Award(ctx, "t3_test", "gid_1", true)
+	require.NoError(t, err)
+}
+
 func TestGoldService_Give(t *testing.T) {
 	client, mux := setup(t)
 
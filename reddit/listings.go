@@ -11,11 +11,58 @@ import (
 //
 // Reddit API docs: https://www.reddit.com/dev/api/#section_listings
 type ListingsService struct {
-	client *Client
+	client  *Client
+	batcher *batcher
 }
 
 // Get posts, comments, and subreddits from their full IDs.
+// At most 100 IDs can be provided, unless the client was configured with the WithBatchWindow option.
+// In that case, lookups made within the window are combined into as few requests as possible,
+// and the results are returned in the order of the IDs.
 func (s *ListingsService) Get(ctx context.Context, ids ...string) ([]*Post, []*Comment, []*Subreddit, *Response, error) {
+	if s.batcher == nil {
+		if len(ids) > maxBatchSize {
+			return nil, nil, nil, nil, fmt.Errorf("cannot provide more than %d ids", maxBatchSize)
+		}
+		return s.get(ctx, ids...)
+	}
+
+	values, resp, err := s.batcher.Lookup(ctx, ids)
+	if err != nil {
+		return nil, nil, nil, resp, err
+	}
+
+	var t things
+	for _, id := range ids {
+		if v, ok := values[id]; ok {
+			t.add(thing{Data: v})
+		}
+	}
+
+	return t.Posts, t.Comments, t.Subreddits, resp, nil
+}
+
+func (s *ListingsService) getBatch(ctx context.Context, ids []string) (map[string]interface{}, *Response, error) {
+	posts, comments, subreddits, resp, err := s.get(ctx, ids...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	values := make(map[string]interface{}, len(posts)+len(comments)+len(subreddits))
+	for _, post := range posts {
+		values[post.FullID] = post
+	}
+	for _, comment := range comments {
+		values[comment.FullID] = comment
+	}
+	for _, subreddit := range subreddits {
+		values[subreddit.FullID] = subreddit
+	}
+
+	return values, resp, nil
+}
+
+func (s *ListingsService) get(ctx context.Context, ids ...string) ([]*Post, []*Comment, []*Subreddit, *Response, error) {
 	path := "api/info"
 	params := struct {
 		IDs []string `url:"id,omitempty,comma"`
@@ -29,8 +76,33 @@ func (s *ListingsService) Get(ctx context.Context, ids ...string) ([]*Post, []*C
 	return l.Posts(), l.Comments(), l.Subreddits(), resp, nil
 }
 
-// GetPosts returns posts from their full IDs.
+// GetByURL returns the posts that link to the URL.
+func (s *ListingsService) GetByURL(ctx context.Context, u string) ([]*Post, *Response, error) {
+	path := "api/info"
+	params := struct {
+		URL string `url:"url"`
+	}{u}
+
+	l, resp, err := s.client.getListing(ctx, path, params)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return l.Posts(), resp, nil
+}
+
+// GetPosts returns posts from their full IDs, without needing to know their subreddits.
+// At most 100 IDs can be provided, and they must all be the full IDs of posts, e.g. t3_abc123.
 func (s *ListingsService) GetPosts(ctx context.Context, ids ...string) ([]*Post, *Response, error) {
+	if len(ids) > maxBatchSize {
+		return nil, nil, fmt.Errorf("cannot provide more than %d ids", maxBatchSize)
+	}
+	for _, id := range ids {
+		if !strings.HasPrefix(id, kindPost+"_") {
+			return nil, nil, fmt.Errorf("%q: must be the full ID of a post", id)
+		}
+	}
+
 	path := fmt.Sprintf("by_id/%s", strings.Join(ids, ","))
 	l, resp, err := s.client.getListing(ctx, path, nil)
 	if err != nil {
@@ -15,7 +15,7 @@ var expectedListingPosts = []*Post{
 		ID:      "i2gvg4",
 		FullID:  "t3_i2gvg4",
 		Created: &Timestamp{time.Date(2020, 8, 2, 18, 23, 8, 0, time.UTC)},
-		Edited:  &Timestamp{time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)},
+		Edited:  &Edited{},
 
 		Permalink: "/r/test/comments/i2gvg4/this_is_a_title/",
 		URL:       "https://www.reddit.com/r/test/comments/i2gvg4/this_is_a_title/",
@@ -37,6 +37,8 @@ var expectedListingPosts = []*Post{
 		AuthorID: "t2_164ab8",
 
 		IsSelfPost: true,
+
+		AllAwardings: []*Award{},
 	},
 }
 
@@ -45,7 +47,7 @@ var expectedListingComments = []*Comment{
 		ID:      "g05v931",
 		FullID:  "t1_g05v931",
 		Created: &Timestamp{time.Date(2020, 8, 3, 1, 15, 40, 0, time.UTC)},
-		Edited:  &Timestamp{time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)},
+		Edited:  &Edited{},
 
 		ParentID:  "t3_i2gvg4",
 		Permalink: "/r/test/comments/i2gvg4/this_is_a_title/g05v931/",
@@ -81,6 +83,19 @@ var expectedListingSubreddits = []*Subreddit{
 		Title:        "Testing",
 		Type:         "public",
 
+		Language: "en",
+
+		SubmissionType:   "any",
+		AllowImages:      true,
+		AllowVideos:      true,
+		AllowGalleries:   true,
+		AllowPolls:       true,
+		SpoilersEnabled:  true,
+		AllowVideoGIFs:   true,
+		RestrictPosting:  true,
+		Crosspostable:    true,
+		LinkFlairEnabled: true,
+
 		Subscribers: 8202,
 		Subscribed:  true,
 	},
@@ -91,7 +106,7 @@ var expectedListingPosts2 = []*Post{
 		ID:      "i2gvg4",
 		FullID:  "t3_i2gvg4",
 		Created: &Timestamp{time.Date(2020, 8, 2, 18, 23, 8, 0, time.UTC)},
-		Edited:  &Timestamp{time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)},
+		Edited:  &Edited{},
 
 		Permalink: "/r/test/comments/i2gvg4/this_is_a_title/",
 		URL:       "https://www.reddit.com/r/test/comments/i2gvg4/this_is_a_title/",
@@ -113,12 +128,14 @@ var expectedListingPosts2 = []*Post{
 		AuthorID: "t2_164ab8",
 
 		IsSelfPost: true,
+
+		AllAwardings: []*Award{},
 	},
 	{
 		ID:      "i2gvs1",
 		FullID:  "t3_i2gvs1",
 		Created: &Timestamp{time.Date(2020, 8, 2, 18, 23, 37, 0, time.UTC)},
-		Edited:  &Timestamp{time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)},
+		Edited:  &Edited{},
 
 		Permalink: "/r/test/comments/i2gvs1/this_is_a_title/",
 		URL:       "http://example.com",
@@ -137,6 +154,8 @@ var expectedListingPosts2 = []*Post{
 
 		Author:   "v_95",
 		AuthorID: "t2_164ab8",
+
+		AllAwardings: []*Award{},
 	},
 }
 
@@ -159,6 +178,9 @@ func TestListingsService_Get(t *testing.T) {
 		fmt.Fprint(w, blob)
 	})
 
+	_, _, _, _, err = client.Listings.Get(ctx, make([]string, 101)...)
+	require.EqualError(t, err, "cannot provide more than 100 ids")
+
 	posts, comments, subreddits, _, err := client.Listings.Get(ctx, "t5_2qh23", "t3_i2gvg4", "t1_g05v931")
 	require.NoError(t, err)
 	require.Equal(t, expectedListingPosts, posts)
@@ -177,7 +199,37 @@ func TestListingsService_GetPosts(t *testing.T) {
 		fmt.Fprint(w, blob)
 	})
 
+	_, _, err = client.Listings.GetPosts(ctx, make([]string, 101)...)
+	require.EqualError(t, err, "cannot provide more than 100 ids")
+
+	_, _, err = client.Listings.GetPosts(ctx, "t3_i2gvg4", "i2gwgz")
+	require.EqualError(t, err, `"i2gwgz": must be the full ID of a post`)
+
 	posts, _, err := client.Listings.GetPosts(ctx, "t3_i2gvg4", "t3_i2gwgz")
 	require.NoError(t, err)
 	require.Equal(t, expectedListingPosts2, posts)
 }
+
+func TestListingsService_GetByURL(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/listings/posts.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/api/info", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		form := url.Values{}
+		form.Set("url", "http://example.com")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.Form)
+
+		fmt.Fprint(w, blob)
+	})
+
+	posts, _, err := client.Listings.GetByURL(ctx, "http://example.com")
+	require.NoError(t, err)
+	require.Equal(t, expectedListingPosts2, posts)
+}
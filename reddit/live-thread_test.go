@@ -108,7 +108,7 @@ var expectedLiveThreadDiscussions = []*Post{
 		ID:      "test1",
 		FullID:  "t3_test1",
 		Created: &Timestamp{time.Date(2020, 9, 16, 12, 37, 31, 0, time.UTC)},
-		Edited:  &Timestamp{time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)},
+		Edited:  &Edited{},
 
 		Permalink: "/r/live/comments/test1/test_title/",
 		URL:       "https://www.reddit.com/live/15nfp4mtfbo14/",
@@ -126,12 +126,21 @@ var expectedLiveThreadDiscussions = []*Post{
 
 		Author:   "TestUser",
 		AuthorID: "t2_test1",
+
+		LinkFlairText: "LIVE THREAD",
+		Media: &PostMedia{
+			Type: "liveupdate",
+		},
+		SecureMedia: &PostMedia{
+			Type: "liveupdate",
+		},
+		AllAwardings: []*Award{},
 	},
 	{
 		ID:      "test2",
 		FullID:  "t3_test2",
 		Created: &Timestamp{time.Date(2020, 9, 16, 12, 37, 1, 0, time.UTC)},
-		Edited:  &Timestamp{time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)},
+		Edited:  &Edited{},
 
 		Permalink: "/r/live/comments/test2/test_title/",
 		URL:       "https://www.reddit.com/live/15nfp4mtfbo14/",
@@ -149,6 +158,20 @@ var expectedLiveThreadDiscussions = []*Post{
 
 		Author:   "TestUser",
 		AuthorID: "t2_test1",
+
+		LinkFlairID:       "9b12fc60-ff01-11e3-b179-12313b0a9e38",
+		LinkFlairText:     "LIVE THREAD CLOSED | No further updates.",
+		LinkFlairCSSClass: "diss",
+		LinkFlairRichText: FlairRichText{
+			{Type: "text", Text: "LIVE THREAD CLOSED | No further updates."},
+		},
+		Media: &PostMedia{
+			Type: "liveupdate",
+		},
+		SecureMedia: &PostMedia{
+			Type: "liveupdate",
+		},
+		AllAwardings: []*Award{},
 	},
 }
 
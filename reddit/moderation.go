@@ -2,10 +2,14 @@ package reddit
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
 	"reflect"
+	"strconv"
+	"strings"
 
 	"github.com/google/go-querystring/query"
 )
@@ -93,10 +97,12 @@ type BanConfig struct {
 	Reason string `url:"reason,omitempty"`
 	// Not visible to the user being banned.
 	ModNote string `url:"note,omitempty"`
-	// How long the ban will last. 0-999. Leave nil for permanent.
+	// How long the ban will last, between 1 and 999 days. Leave nil or 0 for permanent.
 	Days *int `url:"duration,omitempty"`
 	// Note to include in the ban message to the user.
 	Message string `url:"ban_message,omitempty"`
+	// Optional, the full ID of the post or comment that led to the ban.
+	Context string `url:"ban_context,omitempty"`
 }
 
 // Actions gets a list of moderator actions on a subreddit.
@@ -171,6 +177,111 @@ func (s *ModerationService) RemoveSpam(ctx context.Context, id string) (*Respons
 	return s.client.Do(ctx, req, nil)
 }
 
+// RemoveWithReasonRequest represents a request to remove a post or comment and explain why.
+type RemoveWithReasonRequest struct {
+	// Mark the post or comment as spam.
+	Spam bool
+	// Optional, the ID of one of the subreddit's removal reasons.
+	ReasonID string
+	// Optional, a note only visible to moderators. No longer than 100 characters.
+	ModNote string
+
+	// Optional, a message sent to the author. If empty, no message is sent.
+	Message string
+	// Optional, the title of the message. Only used for private messages.
+	MessageTitle string
+	// One of: public (a distinguished reply to the post or comment), private (a modmail
+	// message sent on behalf of the subreddit), private_exposed (a modmail message sent
+	// with your username). Default: public.
+	MessageType string
+}
+
+// RemoveWithReason removes a post or comment via its full ID, attaches a removal reason and/or
+// moderator note to it, and sends the author a removal message, depending on what the request sets.
+func (s *ModerationService) RemoveWithReason(ctx context.Context, id string, request *RemoveWithReasonRequest) (*Response, error) {
+	if request == nil {
+		return nil, errors.New("*RemoveWithReasonRequest: cannot be nil")
+	}
+
+	var kind string
+	switch {
+	case strings.HasPrefix(id, kindPost+"_"):
+		kind = "link"
+	case strings.HasPrefix(id, kindComment+"_"):
+		kind = "comment"
+	default:
+		return nil, fmt.Errorf("%q: must be the full ID of a post or comment", id)
+	}
+
+	messageType := request.MessageType
+	switch messageType {
+	case "":
+		messageType = "public"
+	case "public", "private", "private_exposed":
+	default:
+		return nil, errors.New("(*RemoveWithReasonRequest).MessageType: must be one of: public, private, private_exposed")
+	}
+
+	var resp *Response
+	var err error
+	if request.Spam {
+		resp, err = s.RemoveSpam(ctx, id)
+	} else {
+		resp, err = s.Remove(ctx, id)
+	}
+	if err != nil {
+		return resp, err
+	}
+
+	if request.ReasonID != "" || request.ModNote != "" {
+		data := map[string]interface{}{"item_ids": []string{id}}
+		if request.ReasonID != "" {
+			data["reason_id"] = request.ReasonID
+		}
+		if request.ModNote != "" {
+			data["mod_note"] = request.ModNote
+		}
+
+		resp, err = s.postModAction(ctx, "api/v1/modactions/removal_reasons", data)
+		if err != nil {
+			return resp, err
+		}
+	}
+
+	if request.Message != "" {
+		path := fmt.Sprintf("api/v1/modactions/removal_%s_message", kind)
+		resp, err = s.postModAction(ctx, path, map[string]interface{}{
+			"item_id": []string{id},
+			"message": request.Message,
+			"title":   request.MessageTitle,
+			"type":    messageType,
+		})
+		if err != nil {
+			return resp, err
+		}
+	}
+
+	return resp, nil
+}
+
+// postModAction posts to one of the modactions endpoints, which expect their data as JSON in a form field.
+func (s *ModerationService) postModAction(ctx context.Context, path string, data interface{}) (*Response, error) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{}
+	form.Set("json", string(b))
+
+	req, err := s.client.NewRequest(http.MethodPost, path, form)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
 // Leave abdicates your moderator status in a subreddit via its full ID.
 func (s *ModerationService) Leave(ctx context.Context, subredditID string) (*Response, error) {
 	path := "api/leavemoderator"
@@ -224,14 +335,46 @@ func (s *ModerationService) Spam(ctx context.Context, subreddit string, opts *Li
 // Queue returns posts and comments requiring moderator reviews, such as one that have been
 // reported or caught in the spam filter.
 func (s *ModerationService) Queue(ctx context.Context, subreddit string, opts *ListOptions) ([]*Post, []*Comment, *Response, error) {
-	path := fmt.Sprintf("r/%s/about/modqueue", subreddit)
-	l, resp, err := s.client.getListing(ctx, path, opts)
+	l, resp, err := s.queue(ctx, subreddit, "", opts)
 	if err != nil {
 		return nil, nil, resp, err
 	}
 	return l.Posts(), l.Comments(), resp, nil
 }
 
+// QueuePosts returns only the posts requiring moderator reviews.
+func (s *ModerationService) QueuePosts(ctx context.Context, subreddit string, opts *ListOptions) ([]*Post, *Response, error) {
+	l, resp, err := s.queue(ctx, subreddit, "links", opts)
+	if err != nil {
+		return nil, resp, err
+	}
+	return l.Posts(), resp, nil
+}
+
+// QueueComments returns only the comments requiring moderator reviews.
+func (s *ModerationService) QueueComments(ctx context.Context, subreddit string, opts *ListOptions) ([]*Comment, *Response, error) {
+	l, resp, err := s.queue(ctx, subreddit, "comments", opts)
+	if err != nil {
+		return nil, resp, err
+	}
+	return l.Comments(), resp, nil
+}
+
+// queue gets the mod queue. If only is empty, it contains both posts and comments.
+func (s *ModerationService) queue(ctx context.Context, subreddit, only string, opts *ListOptions) (*listing, *Response, error) {
+	params := struct {
+		Only string `url:"only,omitempty"`
+	}{only}
+
+	path := fmt.Sprintf("r/%s/about/modqueue", subreddit)
+	path, err := addOptions(path, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.client.getListing(ctx, path, opts)
+}
+
 // Unmoderated returns posts that have yet to be approved/removed by a mod.
 func (s *ModerationService) Unmoderated(ctx context.Context, subreddit string, opts *ListOptions) ([]*Post, *Response, error) {
 	path := fmt.Sprintf("r/%s/about/unmoderated", subreddit)
@@ -327,23 +470,7 @@ func (s *ModerationService) SetPermissions(ctx context.Context, subreddit string
 
 // Ban a user from the subreddit.
 func (s *ModerationService) Ban(ctx context.Context, subreddit string, username string, config *BanConfig) (*Response, error) {
-	path := fmt.Sprintf("r/%s/api/friend", subreddit)
-
-	form, err := query.Values(config)
-	if err != nil {
-		return nil, err
-	}
-
-	form.Set("api_type", "json")
-	form.Set("name", username)
-	form.Set("type", "banned")
-
-	req, err := s.client.NewRequest(http.MethodPost, path, form)
-	if err != nil {
-		return nil, err
-	}
-
-	return s.client.Do(ctx, req, nil)
+	return s.ban(ctx, subreddit, username, "banned", config)
 }
 
 // Unban a user from the subreddit.
@@ -353,6 +480,22 @@ func (s *ModerationService) Unban(ctx context.Context, subreddit string, usernam
 
 // BanWiki bans a user from contributing to the subreddit wiki.
 func (s *ModerationService) BanWiki(ctx context.Context, subreddit string, username string, config *BanConfig) (*Response, error) {
+	return s.ban(ctx, subreddit, username, "wikibanned", config)
+}
+
+func (s *ModerationService) ban(ctx context.Context, subreddit, username, banType string, config *BanConfig) (*Response, error) {
+	if config != nil && config.Days != nil {
+		if *config.Days < 0 || *config.Days > 999 {
+			return nil, errors.New("(*BanConfig).Days: must be between 0 and 999")
+		}
+		if *config.Days == 0 {
+			// permanent bans are sent without a duration
+			c := *config
+			c.Days = nil
+			config = &c
+		}
+	}
+
 	path := fmt.Sprintf("r/%s/api/friend", subreddit)
 
 	form, err := query.Values(config)
@@ -362,7 +505,7 @@ func (s *ModerationService) BanWiki(ctx context.Context, subreddit string, usern
 
 	form.Set("api_type", "json")
 	form.Set("name", username)
-	form.Set("type", "wikibanned")
+	form.Set("type", banType)
 
 	req, err := s.client.NewRequest(http.MethodPost, path, form)
 	if err != nil {
@@ -439,15 +582,16 @@ func (s *ModerationService) deleteRelationship(ctx context.Context, subreddit, u
 	return s.client.Do(ctx, req, nil)
 }
 
-// Distinguish your post or comment via its full ID, adding a moderator tag to it.
-// todo: add how=admin and how=special? They require special privileges.
-func (s *ModerationService) Distinguish(ctx context.Context, id string) (*Response, error) {
-	path := "api/distinguish"
+// SetContestMode enables or disables contest mode for the post via its full ID.
+// In contest mode, comments are sorted randomly and regular users cannot see comment scores,
+// i.e. the comments are returned with ScoreHidden set to true.
+func (s *ModerationService) SetContestMode(ctx context.Context, id string, enabled bool) (*Response, error) {
+	path := "api/set_contest_mode"
 
 	form := url.Values{}
 	form.Set("api_type", "json")
-	form.Set("how", "yes")
 	form.Set("id", id)
+	form.Set("state", strconv.FormatBool(enabled))
 
 	req, err := s.client.NewRequest(http.MethodPost, path, form)
 	if err != nil {
@@ -457,32 +601,43 @@ func (s *ModerationService) Distinguish(ctx context.Context, id string) (*Respon
 	return s.client.Do(ctx, req, nil)
 }
 
+// Distinguish your post or comment via its full ID, adding a moderator tag to it.
+func (s *ModerationService) Distinguish(ctx context.Context, id string) (*Response, error) {
+	return s.DistinguishAs(ctx, id, "yes", false)
+}
+
 // DistinguishAndSticky your comment via its full ID, adding a moderator tag to it
 // and stickying the comment at the top of the thread.
 func (s *ModerationService) DistinguishAndSticky(ctx context.Context, id string) (*Response, error) {
-	path := "api/distinguish"
-
-	form := url.Values{}
-	form.Set("api_type", "json")
-	form.Set("how", "yes")
-	form.Set("sticky", "true")
-	form.Set("id", id)
-
-	req, err := s.client.NewRequest(http.MethodPost, path, form)
-	if err != nil {
-		return nil, err
-	}
-
-	return s.client.Do(ctx, req, nil)
+	return s.DistinguishAs(ctx, id, "yes", true)
 }
 
 // Undistinguish your post or comment via its full ID, removing the moderator tag from it.
 func (s *ModerationService) Undistinguish(ctx context.Context, id string) (*Response, error) {
+	return s.DistinguishAs(ctx, id, "no", false)
+}
+
+// DistinguishAs distinguishes your post or comment via its full ID.
+// how is one of: yes (moderator tag), no (removes the tag), admin, special.
+// The admin and special tags require special privileges.
+// If sticky is true, the comment is also stickied at the top of the thread. This only
+// applies to top-level comments.
+func (s *ModerationService) DistinguishAs(ctx context.Context, id string, how string, sticky bool) (*Response, error) {
+	switch how {
+	case "yes", "no", "admin", "special":
+		// intentionally left blank
+	default:
+		return nil, errors.New("how: must be one of: yes, no, admin, special")
+	}
+
 	path := "api/distinguish"
 
 	form := url.Values{}
 	form.Set("api_type", "json")
-	form.Set("how", "no")
+	form.Set("how", how)
+	if sticky {
+		form.Set("sticky", "true")
+	}
 	form.Set("id", id)
 
 	req, err := s.client.NewRequest(http.MethodPost, path, form)
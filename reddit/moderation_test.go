@@ -150,6 +150,76 @@ func TestModerationService_RemoveSpam(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestModerationService_RemoveWithReason(t *testing.T) {
+	client, mux := setup(t)
+
+	var calls []string
+	var expectedReason string
+
+	mux.HandleFunc("/api/remove", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		calls = append(calls, "remove")
+
+		form := url.Values{}
+		form.Set("id", "t3_test")
+		form.Set("spam", "true")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+	})
+
+	mux.HandleFunc("/api/v1/modactions/removal_reasons", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		calls = append(calls, "reason")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.JSONEq(t, expectedReason, r.PostForm.Get("json"))
+	})
+
+	mux.HandleFunc("/api/v1/modactions/removal_link_message", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		calls = append(calls, "message")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.JSONEq(t, `{"item_id": ["t3_test"], "message": "testmessage", "title": "", "type": "public"}`, r.PostForm.Get("json"))
+	})
+
+	_, err := client.Moderation.RemoveWithReason(ctx, "t3_test", nil)
+	require.EqualError(t, err, "*RemoveWithReasonRequest: cannot be nil")
+
+	_, err = client.Moderation.RemoveWithReason(ctx, "t5_test", &RemoveWithReasonRequest{})
+	require.EqualError(t, err, `"t5_test": must be the full ID of a post or comment`)
+
+	_, err = client.Moderation.RemoveWithReason(ctx, "t3_test", &RemoveWithReasonRequest{
+		Message:     "testmessage",
+		MessageType: "modmail",
+	})
+	require.EqualError(t, err, "(*RemoveWithReasonRequest).MessageType: must be one of: public, private, private_exposed")
+	require.Empty(t, calls)
+
+	expectedReason = `{"item_ids": ["t3_test"], "reason_id": "reason1", "mod_note": "testnote"}`
+	_, err = client.Moderation.RemoveWithReason(ctx, "t3_test", &RemoveWithReasonRequest{
+		Spam:     true,
+		ReasonID: "reason1",
+		ModNote:  "testnote",
+		Message:  "testmessage",
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"remove", "reason", "message"}, calls)
+
+	calls = nil
+	expectedReason = `{"item_ids": ["t3_test"], "mod_note": "testnote"}`
+	_, err = client.Moderation.RemoveWithReason(ctx, "t3_test", &RemoveWithReasonRequest{
+		Spam:    true,
+		ModNote: "testnote",
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"remove", "reason"}, calls)
+}
+
 func TestModerationService_Leave(t *testing.T) {
 	client, mux := setup(t)
 
@@ -210,6 +280,59 @@ func TestModerationService_Reported(t *testing.T) {
 	require.Equal(t, "t1_f0zsa37", resp.After)
 }
 
+func TestModerationService_Reported_Reports(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/r/testsubreddit/about/reports", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, `{
+			"kind": "Listing",
+			"data": {
+				"children": [
+					{
+						"kind": "t3",
+						"data": {
+							"name": "t3_test",
+							"num_reports": 3,
+							"user_reports": [["spam", 2, false, true], ["off topic", 1]],
+							"mod_reports": [["rule 1", "testmod"]]
+						}
+					},
+					{
+						"kind": "t1",
+						"data": {
+							"name": "t1_test",
+							"num_reports": 1,
+							"user_reports": [["harassment", 1, true, true]],
+							"mod_reports": []
+						}
+					}
+				]
+			}
+		}`)
+	})
+
+	posts, comments, _, err := client.Moderation.Reported(ctx, "testsubreddit", nil)
+	require.NoError(t, err)
+
+	require.Len(t, posts, 1)
+	require.Equal(t, 3, posts[0].NumReports)
+	require.Equal(t, UserReports{
+		{Reason: "spam", Count: 2, CanSnooze: true},
+		{Reason: "off topic", Count: 1},
+	}, posts[0].UserReports)
+	require.Equal(t, ModReports{
+		{Reason: "rule 1", Moderator: "testmod"},
+	}, posts[0].ModReports)
+
+	require.Len(t, comments, 1)
+	require.Equal(t, 1, comments[0].NumReports)
+	require.Equal(t, UserReports{
+		{Reason: "harassment", Count: 1, Snoozed: true, CanSnooze: true},
+	}, comments[0].UserReports)
+	require.Nil(t, comments[0].ModReports)
+}
+
 func TestModerationService_Spam(t *testing.T) {
 	client, mux := setup(t)
 
@@ -258,6 +381,57 @@ func TestModerationService_Queue(t *testing.T) {
 	require.Equal(t, "t1_f0zsa37", resp.After)
 }
 
+func TestModerationService_QueuePosts(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/subreddit/posts.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/r/testsubreddit/about/modqueue", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		form := url.Values{}
+		form.Set("only", "links")
+		form.Set("limit", "10")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.Form)
+
+		fmt.Fprint(w, blob)
+	})
+
+	posts, resp, err := client.Moderation.QueuePosts(ctx, "testsubreddit", &ListOptions{Limit: 10})
+	require.NoError(t, err)
+	require.Equal(t, expectedPosts, posts)
+	require.Equal(t, "t3_hyhquk", resp.After)
+}
+
+func TestModerationService_QueueComments(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/user/overview.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/r/testsubreddit/about/modqueue", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		form := url.Values{}
+		form.Set("only", "comments")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.Form)
+
+		fmt.Fprint(w, blob)
+	})
+
+	comments, _, err := client.Moderation.QueueComments(ctx, "testsubreddit", nil)
+	require.NoError(t, err)
+	require.Len(t, comments, 1)
+	require.Equal(t, expectedComment, comments[0])
+}
+
 func TestModerationService_Unmoderated(t *testing.T) {
 	client, mux := setup(t)
 
@@ -435,6 +609,7 @@ func TestModerationService_Ban(t *testing.T) {
 		form.Set("note", "test mod note")
 		form.Set("duration", "5")
 		form.Set("ban_message", "test message")
+		form.Set("ban_context", "t1_test")
 
 		err := r.ParseForm()
 		require.NoError(t, err)
@@ -446,10 +621,42 @@ func TestModerationService_Ban(t *testing.T) {
 		ModNote: "test mod note",
 		Days:    Int(5),
 		Message: "test message",
+		Context: "t1_test",
 	})
 	require.NoError(t, err)
 }
 
+func TestModerationService_Ban_Days(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/r/testsubreddit/api/friend", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("api_type", "json")
+		form.Set("name", "testuser")
+		form.Set("type", "banned")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+	})
+
+	_, err := client.Moderation.Ban(ctx, "testsubreddit", "testuser", &BanConfig{Days: Int(1000)})
+	require.EqualError(t, err, "(*BanConfig).Days: must be between 0 and 999")
+
+	_, err = client.Moderation.Ban(ctx, "testsubreddit", "testuser", &BanConfig{Days: Int(-1)})
+	require.EqualError(t, err, "(*BanConfig).Days: must be between 0 and 999")
+
+	config := &BanConfig{Days: Int(0)}
+	_, err = client.Moderation.Ban(ctx, "testsubreddit", "testuser", config)
+	require.NoError(t, err)
+	require.Equal(t, Int(0), config.Days)
+
+	_, err = client.Moderation.Ban(ctx, "testsubreddit", "testuser", nil)
+	require.NoError(t, err)
+}
+
 func TestModerationService_Unban(t *testing.T) {
 	client, mux := setup(t)
 
@@ -680,6 +887,26 @@ func TestModerationService_DistinguishAndSticky(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestModerationService_SetContestMode(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/api/set_contest_mode", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("api_type", "json")
+		form.Set("id", "t3_test")
+		form.Set("state", "true")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+	})
+
+	_, err := client.Moderation.SetContestMode(ctx, "t3_test", true)
+	require.NoError(t, err)
+}
+
 func TestModerationService_Undistinguish(t *testing.T) {
 	client, mux := setup(t)
 
@@ -699,3 +926,27 @@ func TestModerationService_Undistinguish(t *testing.T) {
 	_, err := client.Moderation.Undistinguish(ctx, "t1_123")
 	require.NoError(t, err)
 }
+
+func TestModerationService_DistinguishAs(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/api/distinguish", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("api_type", "json")
+		form.Set("how", "admin")
+		form.Set("sticky", "true")
+		form.Set("id", "t1_123")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+	})
+
+	_, err := client.Moderation.DistinguishAs(ctx, "t1_123", "test", false)
+	require.EqualError(t, err, "how: must be one of: yes, no, admin, special")
+
+	_, err = client.Moderation.DistinguishAs(ctx, "t1_123", "admin", true)
+	require.NoError(t, err)
+}
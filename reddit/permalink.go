@@ -0,0 +1,83 @@
+package reddit
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Permalink identifies the post (and optionally the comment) that a Reddit URL points to.
+type Permalink struct {
+	// Empty for shortlinks (e.g. https://redd.it/abc123), which do not contain the subreddit.
+	// For posts made to a user's profile, this is "u_" followed by the username.
+	Subreddit string
+	// ID36 of the post.
+	PostID string
+	// ID36 of the comment. Empty if the URL points to the post itself.
+	CommentID string
+}
+
+// ParsePermalink parses a URL pointing to a post or comment on Reddit.
+// It supports all of Reddit's domains (www, old, new, np, etc.), shortlinks,
+// as well as share links (whose query parameters are ignored).
+// Examples:
+//
+//	https://www.reddit.com/r/golang/comments/abc123/some_title/
+//	https://old.reddit.com/r/golang/comments/abc123/some_title/def456/?context=3
+//	https://www.reddit.com/comments/abc123
+//	https://www.reddit.com/user/someone/comments/abc123/some_title/
+//	https://redd.it/abc123
+func ParsePermalink(rawURL string) (*Permalink, error) {
+	if !strings.Contains(rawURL, "://") {
+		rawURL = "https://" + rawURL
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	host := strings.ToLower(u.Hostname())
+	segments := strings.FieldsFunc(u.Path, func(r rune) bool { return r == '/' })
+
+	if host == "redd.it" || host == "www.redd.it" {
+		if len(segments) != 1 {
+			return nil, fmt.Errorf("invalid shortlink: %q", rawURL)
+		}
+		return &Permalink{PostID: segments[0]}, nil
+	}
+
+	// media hosts, e.g. i.redd.it and v.redd.it, serve the files of posts, not the posts themselves
+	if strings.HasSuffix(host, ".redd.it") {
+		return nil, fmt.Errorf("media URL does not point to a post: %q", rawURL)
+	}
+
+	if host != "reddit.com" && !strings.HasSuffix(host, ".reddit.com") {
+		return nil, fmt.Errorf("not a reddit URL: %q", rawURL)
+	}
+
+	permalink := new(Permalink)
+
+	if len(segments) >= 2 {
+		switch segments[0] {
+		case "r":
+			permalink.Subreddit = segments[1]
+			segments = segments[2:]
+		case "u", "user":
+			permalink.Subreddit = "u_" + segments[1]
+			segments = segments[2:]
+		}
+	}
+
+	// the remaining segments are: comments/{post id}/{title}/{comment id}
+	if len(segments) < 2 || segments[0] != "comments" {
+		return nil, fmt.Errorf("cannot find post ID in URL: %q", rawURL)
+	}
+
+	permalink.PostID = segments[1]
+	if len(segments) >= 4 {
+		permalink.CommentID = segments[3]
+	}
+
+	return permalink, nil
+}
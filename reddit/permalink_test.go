@@ -0,0 +1,38 @@
+package reddit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePermalink(t *testing.T) {
+	testCases := []struct {
+		url     string
+		want    *Permalink
+		wantErr string
+	}{
+		{"https://www.reddit.com/r/golang/comments/abc123/some_title/", &Permalink{Subreddit: "golang", PostID: "abc123"}, ""},
+		{"https://old.reddit.com/r/golang/comments/abc123/some_title/def456/?context=3", &Permalink{Subreddit: "golang", PostID: "abc123", CommentID: "def456"}, ""},
+		{"https://www.reddit.com/r/golang/comments/abc123/some_title/?utm_source=share&utm_medium=web2x", &Permalink{Subreddit: "golang", PostID: "abc123"}, ""},
+		{"https://new.reddit.com/r/golang/comments/abc123/_/def456", &Permalink{Subreddit: "golang", PostID: "abc123", CommentID: "def456"}, ""},
+		{"reddit.com/comments/abc123", &Permalink{PostID: "abc123"}, ""},
+		{"https://www.reddit.com/user/someone/comments/abc123/some_title/", &Permalink{Subreddit: "u_someone", PostID: "abc123"}, ""},
+		{"https://redd.it/abc123", &Permalink{PostID: "abc123"}, ""},
+		{"https://www.redd.it/abc123", &Permalink{PostID: "abc123"}, ""},
+		{"https://v.redd.it/ra4qnt8bt8d51", nil, `media URL does not point to a post: "https://v.redd.it/ra4qnt8bt8d51"`},
+		{"https://i.redd.it/abc123.jpg", nil, `media URL does not point to a post: "https://i.redd.it/abc123.jpg"`},
+		{"https://example.com/r/golang/comments/abc123", nil, `not a reddit URL: "https://example.com/r/golang/comments/abc123"`},
+		{"https://www.reddit.com/r/golang/", nil, `cannot find post ID in URL: "https://www.reddit.com/r/golang/"`},
+	}
+
+	for _, tc := range testCases {
+		permalink, err := ParsePermalink(tc.url)
+		if tc.wantErr != "" {
+			require.EqualError(t, err, tc.wantErr)
+			continue
+		}
+		require.NoError(t, err)
+		require.Equal(t, tc.want, permalink)
+	}
+}
@@ -2,9 +2,15 @@ package reddit
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/go-querystring/query"
 )
 
 // postAndCommentService handles communication with the post and comment
@@ -16,15 +22,59 @@ type postAndCommentService struct {
 	client *Client
 }
 
-type vote int
+// Vote is a vote on a post or comment.
+type Vote int
 
 // Reddit interprets -1, 0, 1 as downvote, no vote, and upvote, respectively.
 const (
-	downvote vote = iota - 1
-	novote
-	upvote
+	VoteDown Vote = iota - 1
+	VoteNone
+	VoteUp
 )
 
+func (v Vote) validate() error {
+	if v < VoteDown || v > VoteUp {
+		return fmt.Errorf("vote: must be one of %d, %d, %d", VoteDown, VoteNone, VoteUp)
+	}
+	return nil
+}
+
+// voteFromLikes converts the likes field of a post or comment to a Vote.
+func voteFromLikes(likes *bool) Vote {
+	switch {
+	case likes == nil:
+		return VoteNone
+	case *likes:
+		return VoteUp
+	default:
+		return VoteDown
+	}
+}
+
+// ReportOptions are the reasons used when reporting a post or comment.
+// At least one of them must be set, and none of them can be longer than 100 characters.
+// The reasons available for a subreddit can be obtained via SubredditService.ReportReasons.
+type ReportOptions struct {
+	// A reason from Reddit's site-wide rules.
+	SiteReason string `url:"site_reason,omitempty"`
+	// The violation reason of one of the subreddit's rules.
+	RuleReason string `url:"rule_reason,omitempty"`
+	// Any other reason.
+	FreeText string `url:"other_reason,omitempty"`
+}
+
+func (r ReportOptions) validate() error {
+	if r.SiteReason == "" && r.RuleReason == "" && r.FreeText == "" {
+		return errors.New("ReportOptions: must provide at least 1 reason")
+	}
+	for _, reason := range []string{r.SiteReason, r.RuleReason, r.FreeText} {
+		if utf8.RuneCountInString(reason) > 100 {
+			return errors.New("ReportOptions: reasons cannot be longer than 100 characters")
+		}
+	}
+	return nil
+}
+
 // Delete a post or comment via its full ID.
 func (s *postAndCommentService) Delete(ctx context.Context, id string) (*Response, error) {
 	path := "api/del"
@@ -42,10 +92,23 @@ func (s *postAndCommentService) Delete(ctx context.Context, id string) (*Respons
 
 // Save a post or comment.
 func (s *postAndCommentService) Save(ctx context.Context, id string) (*Response, error) {
+	return s.save(ctx, id, "")
+}
+
+// SaveToCategory saves a post or comment to a category.
+// Saved categories are only available to Reddit Premium users.
+func (s *postAndCommentService) SaveToCategory(ctx context.Context, id string, category string) (*Response, error) {
+	return s.save(ctx, id, category)
+}
+
+func (s *postAndCommentService) save(ctx context.Context, id string, category string) (*Response, error) {
 	path := "api/save"
 
 	form := url.Values{}
 	form.Set("id", id)
+	if category != "" {
+		form.Set("category", category)
+	}
 
 	req, err := s.client.NewRequest(http.MethodPost, path, form)
 	if err != nil {
@@ -86,7 +149,7 @@ func (s *postAndCommentService) EnableReplies(ctx context.Context, id string) (*
 	return s.client.Do(ctx, req, nil)
 }
 
-// DisableReplies dsables inbox replies for one of your posts or comments.
+// DisableReplies disables inbox replies for one of your posts or comments.
 func (s *postAndCommentService) DisableReplies(ctx context.Context, id string) (*Response, error) {
 	path := "api/sendreplies"
 
@@ -132,7 +195,12 @@ func (s *postAndCommentService) Unlock(ctx context.Context, id string) (*Respons
 	return s.client.Do(ctx, req, nil)
 }
 
-func (s *postAndCommentService) vote(ctx context.Context, id string, vote vote) (*Response, error) {
+// Vote on a post or a comment.
+func (s *postAndCommentService) Vote(ctx context.Context, id string, vote Vote) (*Response, error) {
+	if err := vote.validate(); err != nil {
+		return nil, err
+	}
+
 	path := "api/vote"
 
 	form := url.Values{}
@@ -150,17 +218,17 @@ func (s *postAndCommentService) vote(ctx context.Context, id string, vote vote)
 
 // Upvote a post or a comment.
 func (s *postAndCommentService) Upvote(ctx context.Context, id string) (*Response, error) {
-	return s.vote(ctx, id, upvote)
+	return s.Vote(ctx, id, VoteUp)
 }
 
 // Downvote a post or a comment.
 func (s *postAndCommentService) Downvote(ctx context.Context, id string) (*Response, error) {
-	return s.vote(ctx, id, downvote)
+	return s.Vote(ctx, id, VoteDown)
 }
 
 // RemoveVote removes your vote on a post or a comment.
 func (s *postAndCommentService) RemoveVote(ctx context.Context, id string) (*Response, error) {
-	return s.vote(ctx, id, novote)
+	return s.Vote(ctx, id, VoteNone)
 }
 
 // Report a post or comment.
@@ -180,3 +248,59 @@ func (s *postAndCommentService) Report(ctx context.Context, id string, reason st
 
 	return s.client.Do(ctx, req, nil)
 }
+
+// ReportWithOptions reports a post or comment, using a site-wide rule,
+// a subreddit rule, and/or a custom reason.
+func (s *postAndCommentService) ReportWithOptions(ctx context.Context, id string, opts ReportOptions) (*Response, error) {
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
+	path := "api/report"
+
+	form, err := query.Values(opts)
+	if err != nil {
+		return nil, err
+	}
+	form.Set("api_type", "json")
+	form.Set("thing_id", id)
+
+	req, err := s.client.NewRequest(http.MethodPost, path, form)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// moreChildren retrieves the comments and "more" nodes that were left out of the post's comment tree.
+// postID is the full ID of the post, and children are the IDs of the comments to retrieve.
+func (s *postAndCommentService) moreChildren(ctx context.Context, postID string, children []string) ([]*Comment, []*More, *Response, error) {
+	form := url.Values{}
+	form.Set("api_type", "json")
+	form.Set("link_id", postID)
+	form.Set("children", strings.Join(children, ","))
+
+	path := "api/morechildren"
+
+	// This was originally a GET, but with POST you can send a bigger payload
+	// since it's in the body and not the URI.
+	req, err := s.client.NewRequest(http.MethodPost, path, form)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	root := new(struct {
+		JSON struct {
+			Data struct {
+				Things things `json:"things"`
+			} `json:"data"`
+		} `json:"json"`
+	})
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, nil, resp, err
+	}
+
+	return root.JSON.Data.Things.Comments, root.JSON.Data.Things.Mores, resp, nil
+}
@@ -2,13 +2,23 @@ package reddit
 
 import (
 	"context"
+	"encoding/xml"
 	"errors"
 	"fmt"
+	"html"
 	"net/http"
 	"net/url"
+	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
 
 	"github.com/google/go-querystring/query"
+	"golang.org/x/net/context/ctxhttp"
+	"golang.org/x/net/websocket"
 )
 
 // PostService handles communication with the post
@@ -31,6 +41,19 @@ type Submitted struct {
 	ID     string `json:"id,omitempty"`
 	FullID string `json:"name,omitempty"`
 	URL    string `json:"url,omitempty"`
+
+	// Posts with uploaded media are only created once Reddit is done processing the media,
+	// so their ID and URL are unknown when submitting them. Instead, a websocket URL is
+	// returned, which will receive a message once the post has been created.
+	WebSocketURL string `json:"websocket_url,omitempty"`
+}
+
+// validateTitle checks that a post title is between 1 and 300 characters long.
+func validateTitle(request, title string) error {
+	if title == "" || utf8.RuneCountInString(title) > 300 {
+		return fmt.Errorf("(%s).Title: must be between 1-300 characters", request)
+	}
+	return nil
 }
 
 // SubmitTextRequest are options used for text posts.
@@ -47,6 +70,16 @@ type SubmitTextRequest struct {
 	Spoiler     bool  `url:"spoiler,omitempty"`
 }
 
+func (r SubmitTextRequest) validate() error {
+	if r.Subreddit == "" {
+		return errors.New("(SubmitTextRequest).Subreddit: cannot be empty")
+	}
+	if err := validateTitle("SubmitTextRequest", r.Title); err != nil {
+		return err
+	}
+	return nil
+}
+
 // SubmitLinkRequest are options used for link posts.
 type SubmitLinkRequest struct {
 	Subreddit string `url:"sr,omitempty"`
@@ -62,11 +95,162 @@ type SubmitLinkRequest struct {
 	Spoiler     bool  `url:"spoiler,omitempty"`
 }
 
+func (r SubmitLinkRequest) validate() error {
+	if r.Subreddit == "" {
+		return errors.New("(SubmitLinkRequest).Subreddit: cannot be empty")
+	}
+	if err := validateTitle("SubmitLinkRequest", r.Title); err != nil {
+		return err
+	}
+	if r.URL == "" {
+		return errors.New("(SubmitLinkRequest).URL: cannot be empty")
+	}
+	return nil
+}
+
+// SubmitImageRequest are options used for image posts.
+type SubmitImageRequest struct {
+	Subreddit string `url:"sr,omitempty"`
+	Title     string `url:"title,omitempty"`
+	// Path to the image file to upload. Must be a PNG, JPEG, or GIF.
+	ImagePath string `url:"-"`
+
+	FlairID   string `url:"flair_id,omitempty"`
+	FlairText string `url:"flair_text,omitempty"`
+
+	SendReplies *bool `url:"sendreplies,omitempty"`
+	NSFW        bool  `url:"nsfw,omitempty"`
+	Spoiler     bool  `url:"spoiler,omitempty"`
+}
+
+func (r SubmitImageRequest) validate() error {
+	if r.Subreddit == "" {
+		return errors.New("(SubmitImageRequest).Subreddit: cannot be empty")
+	}
+	if err := validateTitle("SubmitImageRequest", r.Title); err != nil {
+		return err
+	}
+	if r.ImagePath == "" {
+		return errors.New("(SubmitImageRequest).ImagePath: cannot be empty")
+	}
+	return nil
+}
+
+// SubmitVideoRequest are options used for video posts.
+type SubmitVideoRequest struct {
+	Subreddit string `url:"sr,omitempty"`
+	Title     string `url:"title,omitempty"`
+	// Path to the video file to upload. Must be an MP4 or MOV.
+	VideoPath string `url:"-"`
+	// Optional path to an image file to upload and use as the video's thumbnail.
+	ThumbnailPath string `url:"-"`
+	// If true, the video is submitted as a silent, looping GIF.
+	VideoGIF bool `url:"-"`
+
+	FlairID   string `url:"flair_id,omitempty"`
+	FlairText string `url:"flair_text,omitempty"`
+
+	SendReplies *bool `url:"sendreplies,omitempty"`
+	NSFW        bool  `url:"nsfw,omitempty"`
+	Spoiler     bool  `url:"spoiler,omitempty"`
+}
+
+func (r SubmitVideoRequest) validate() error {
+	if r.Subreddit == "" {
+		return errors.New("(SubmitVideoRequest).Subreddit: cannot be empty")
+	}
+	if err := validateTitle("SubmitVideoRequest", r.Title); err != nil {
+		return err
+	}
+	if r.VideoPath == "" {
+		return errors.New("(SubmitVideoRequest).VideoPath: cannot be empty")
+	}
+	return nil
+}
+
+// Media file types accepted by Reddit, by file extension.
+var mediaTypes = map[string]string{
+	".png":  "image/png",
+	".jpg":  "image/jpeg",
+	".jpeg": "image/jpeg",
+	".gif":  "image/gif",
+	".mp4":  "video/mp4",
+	".mov":  "video/quicktime",
+}
+
+// checkMediaKind checks that the media file is of the given kind, i.e. "image" or "video".
+func checkMediaKind(mediaPath, kind string) error {
+	mimeType := mediaTypes[strings.ToLower(filepath.Ext(mediaPath))]
+	if !strings.HasPrefix(mimeType, kind+"/") {
+		return fmt.Errorf("unsupported %s file type: %q", kind, filepath.Ext(mediaPath))
+	}
+	return nil
+}
+
+// uploadedMedia is a media file that has been uploaded to Reddit's servers.
+type uploadedMedia struct {
+	ID           string
+	URL          string
+	WebSocketURL string
+}
+
+// uploadMedia uploads a media file (image or video) to Reddit, so that it can be used in a post.
+// First, an upload lease is requested, which contains the Amazon S3 URL and fields
+// to use to upload the file. Then, the file is uploaded to that URL.
+func (s *PostService) uploadMedia(ctx context.Context, mediaPath string) (*uploadedMedia, *Response, error) {
+	mimeType, ok := mediaTypes[strings.ToLower(filepath.Ext(mediaPath))]
+	if !ok {
+		return nil, nil, fmt.Errorf("unsupported media file type: %q", filepath.Ext(mediaPath))
+	}
+
+	path := "api/media/asset.json"
+
+	form := url.Values{}
+	form.Set("filepath", filepath.Base(mediaPath))
+	form.Set("mimetype", mimeType)
+
+	req, err := s.client.NewRequest(http.MethodPost, path, form)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(struct {
+		Args  uploadLease `json:"args"`
+		Asset struct {
+			ID           string `json:"asset_id"`
+			WebSocketURL string `json:"websocket_url"`
+		} `json:"asset"`
+	})
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	mediaURL, resp, err := s.client.uploadWithLease(ctx, root.Args, mediaPath)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	media := &uploadedMedia{
+		ID:           root.Asset.ID,
+		URL:          mediaURL,
+		WebSocketURL: root.Asset.WebSocketURL,
+	}
+
+	return media, resp, nil
+}
+
 // Get a post with its comments.
 // id is the ID36 of the post, not its full id.
 // Example: instead of t3_abc123, use abc123.
-func (s *PostService) Get(ctx context.Context, id string) (*PostAndComments, *Response, error) {
+// The options can be nil, in which case Reddit's defaults are used.
+func (s *PostService) Get(ctx context.Context, id string, opts *ListPostCommentsOptions) (*PostAndComments, *Response, error) {
 	path := fmt.Sprintf("comments/%s", id)
+	path, err := addOptions(path, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	req, err := s.client.NewRequest(http.MethodGet, path, nil)
 	if err != nil {
 		return nil, nil, err
@@ -105,7 +289,13 @@ func (s *PostService) Duplicates(ctx context.Context, id string, opts *ListDupli
 	listing1, _ := root[0].Listing()
 	listing2, _ := root[1].Listing()
 
-	post := listing1.Posts()[0]
+	// if the post doesn't exist, reddit returns empty listings instead of a 404
+	posts := listing1.Posts()
+	if len(posts) == 0 {
+		return nil, nil, resp, fmt.Errorf("cannot find post with id %q", id)
+	}
+
+	post := posts[0]
 	duplicates := listing2.Posts()
 
 	resp.After = listing2.After()
@@ -136,7 +326,12 @@ func (s *PostService) submit(ctx context.Context, v interface{}) (*Submitted, *R
 }
 
 // SubmitText submits a text post.
+// The subreddit and title are required, and the title cannot be longer than 300 characters.
 func (s *PostService) SubmitText(ctx context.Context, opts SubmitTextRequest) (*Submitted, *Response, error) {
+	if err := opts.validate(); err != nil {
+		return nil, nil, err
+	}
+
 	form := struct {
 		SubmitTextRequest
 		Kind string `url:"kind,omitempty"`
@@ -145,14 +340,176 @@ func (s *PostService) SubmitText(ctx context.Context, opts SubmitTextRequest) (*
 }
 
 // SubmitLink submits a link post.
+// The subreddit, title and URL are required, and the title cannot be longer than 300 characters.
+// If the link was already submitted to the subreddit and Resubmit is false, an *AlreadySubmittedError
+// is returned, containing the existing submissions.
 func (s *PostService) SubmitLink(ctx context.Context, opts SubmitLinkRequest) (*Submitted, *Response, error) {
+	if err := opts.validate(); err != nil {
+		return nil, nil, err
+	}
+
 	form := struct {
 		SubmitLinkRequest
 		Kind string `url:"kind,omitempty"`
 	}{opts, "link"}
+
+	submitted, resp, err := s.submit(ctx, form)
+	if jsonErr, ok := err.(*JSONErrorResponse); ok && jsonErr.hasLabel("ALREADY_SUB") {
+		alreadySubmittedErr := &AlreadySubmittedError{JSONErrorResponse: jsonErr}
+
+		// if the lookup fails, the original error is still returned, just without the existing posts
+		posts, _, lookupErr := s.client.Listings.GetByURL(ctx, opts.URL)
+		if lookupErr == nil {
+			for _, post := range posts {
+				if strings.EqualFold(post.SubredditName, opts.Subreddit) {
+					alreadySubmittedErr.Posts = append(alreadySubmittedErr.Posts, post)
+				}
+			}
+		}
+
+		return nil, resp, alreadySubmittedErr
+	}
+
+	return submitted, resp, err
+}
+
+// SubmitImage uploads an image and submits it as an image post.
+// The subreddit, title and image path are required, and the title cannot be longer than 300 characters.
+// The post is only created once Reddit is done processing the image, so the returned *Submitted
+// does not contain the post's ID and URL, but a websocket URL to be notified of its creation.
+func (s *PostService) SubmitImage(ctx context.Context, opts SubmitImageRequest) (*Submitted, *Response, error) {
+	if err := opts.validate(); err != nil {
+		return nil, nil, err
+	}
+	if err := checkMediaKind(opts.ImagePath, "image"); err != nil {
+		return nil, nil, err
+	}
+
+	media, resp, err := s.uploadMedia(ctx, opts.ImagePath)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	form := struct {
+		SubmitImageRequest
+		Kind string `url:"kind,omitempty"`
+		URL  string `url:"url,omitempty"`
+	}{opts, "image", media.URL}
+	return s.submit(ctx, form)
+}
+
+// SubmitVideo uploads a video (and optionally a thumbnail) and submits it as a video post.
+// The subreddit, title and video path are required, and the title cannot be longer than 300 characters.
+// Like with SubmitImage, the returned *Submitted only contains a websocket URL;
+// use WaitForSubmission to wait until Reddit is done processing the video.
+func (s *PostService) SubmitVideo(ctx context.Context, opts SubmitVideoRequest) (*Submitted, *Response, error) {
+	if err := opts.validate(); err != nil {
+		return nil, nil, err
+	}
+	if err := checkMediaKind(opts.VideoPath, "video"); err != nil {
+		return nil, nil, err
+	}
+	if opts.ThumbnailPath != "" {
+		if err := checkMediaKind(opts.ThumbnailPath, "image"); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	video, resp, err := s.uploadMedia(ctx, opts.VideoPath)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var posterURL string
+	if opts.ThumbnailPath != "" {
+		poster, resp, err := s.uploadMedia(ctx, opts.ThumbnailPath)
+		if err != nil {
+			return nil, resp, err
+		}
+		posterURL = poster.URL
+	}
+
+	kind := "video"
+	if opts.VideoGIF {
+		kind = "videogif"
+	}
+
+	form := struct {
+		SubmitVideoRequest
+		Kind      string `url:"kind,omitempty"`
+		URL       string `url:"url,omitempty"`
+		PosterURL string `url:"video_poster_url,omitempty"`
+	}{opts, kind, video.URL, posterURL}
 	return s.submit(ctx, form)
 }
 
+// WaitForSubmission waits until Reddit is done processing the media of a post submitted with
+// SubmitImage or SubmitVideo, and returns the *Submitted with the ID and URL of the created post.
+// If the post has no websocket URL, it is returned as is.
+func (s *PostService) WaitForSubmission(ctx context.Context, submitted *Submitted) (*Submitted, error) {
+	if submitted == nil {
+		return nil, errors.New("*Submitted: cannot be nil")
+	}
+	if submitted.WebSocketURL == "" {
+		return submitted, nil
+	}
+
+	config, err := websocket.NewConfig(submitted.WebSocketURL, s.client.BaseURL.String())
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := websocket.DialConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	// the websocket package does not support contexts, so the connection
+	// is closed to unblock the read if the context is done first
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			conn.Close()
+		case <-done:
+		}
+	}()
+
+	for {
+		var message struct {
+			Type    string `json:"type"`
+			Payload struct {
+				Redirect string `json:"redirect"`
+			} `json:"payload"`
+		}
+		if err := websocket.JSON.Receive(conn, &message); err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			return nil, err
+		}
+
+		switch message.Type {
+		case "success":
+			return submittedFromPermalink(message.Payload.Redirect)
+		case "failed":
+			return nil, errors.New("reddit failed to process the submitted media")
+		}
+	}
+}
+
+// submittedFromPermalink builds a *Submitted from the URL of a post,
+// e.g. https://www.reddit.com/r/test/comments/abc123/test_title/
+func submittedFromPermalink(u string) (*Submitted, error) {
+	permalink, err := ParsePermalink(u)
+	if err != nil {
+		return nil, err
+	}
+	return &Submitted{ID: permalink.PostID, FullID: kindPost + "_" + permalink.PostID, URL: u}, nil
+}
+
 // Edit a post.
 func (s *PostService) Edit(ctx context.Context, id string, text string) (*Post, *Response, error) {
 	path := "api/editusertext"
@@ -177,11 +534,18 @@ func (s *PostService) Edit(ctx context.Context, id string, text string) (*Post,
 	return root, resp, nil
 }
 
+// Maximum number of posts that can be hidden or unhidden in a single request.
+const maxHideIDs = 50
+
 // Hide posts.
+// At most 50 posts can be hidden in a single call.
 func (s *PostService) Hide(ctx context.Context, ids ...string) (*Response, error) {
 	if len(ids) == 0 {
 		return nil, errors.New("must provide at least 1 id")
 	}
+	if len(ids) > maxHideIDs {
+		return nil, fmt.Errorf("cannot provide more than %d ids", maxHideIDs)
+	}
 
 	path := "api/hide"
 
@@ -197,10 +561,14 @@ func (s *PostService) Hide(ctx context.Context, ids ...string) (*Response, error
 }
 
 // Unhide posts.
+// At most 50 posts can be unhidden in a single call.
 func (s *PostService) Unhide(ctx context.Context, ids ...string) (*Response, error) {
 	if len(ids) == 0 {
 		return nil, errors.New("must provide at least 1 id")
 	}
+	if len(ids) > maxHideIDs {
+		return nil, fmt.Errorf("cannot provide more than %d ids", maxHideIDs)
+	}
 
 	path := "api/unhide"
 
@@ -432,12 +800,28 @@ func (s *PostService) ClearSuggestedSort(ctx context.Context, id string) (*Respo
 // EnableContestMode enables contest mode for the post.
 // Comments will be sorted randomly and regular users cannot see comment scores.
 func (s *PostService) EnableContestMode(ctx context.Context, id string) (*Response, error) {
-	path := "api/set_contest_mode"
+	return s.client.Moderation.SetContestMode(ctx, id, true)
+}
+
+// DisableContestMode disables contest mode for the post.
+func (s *PostService) DisableContestMode(ctx context.Context, id string) (*Response, error) {
+	return s.client.Moderation.SetContestMode(ctx, id, false)
+}
+
+// SetCrowdControlLevel sets how strictly comments from users who aren't trusted members of
+// the community are collapsed in the post's comment section.
+// The level must be between 0 (off) and 3 (strict).
+func (s *PostService) SetCrowdControlLevel(ctx context.Context, id string, level int) (*Response, error) {
+	if level < 0 || level > 3 {
+		return nil, errors.New("level: must be between 0 and 3")
+	}
+
+	path := "api/update_crowd_control_level"
 
 	form := url.Values{}
 	form.Set("api_type", "json")
 	form.Set("id", id)
-	form.Set("state", "true")
+	form.Set("level", strconv.Itoa(level))
 
 	req, err := s.client.NewRequest(http.MethodPost, path, form)
 	if err != nil {
@@ -447,14 +831,34 @@ func (s *PostService) EnableContestMode(ctx context.Context, id string) (*Respon
 	return s.client.Do(ctx, req, nil)
 }
 
-// DisableContestMode disables contest mode for the post.
-func (s *PostService) DisableContestMode(ctx context.Context, id string) (*Response, error) {
-	path := "api/set_contest_mode"
+// SetEventTime turns a post into an event post, taking place between the start and end times.
+// The end time must be after the start time.
+func (s *PostService) SetEventTime(ctx context.Context, id string, start, end time.Time) (*Response, error) {
+	if !end.After(start) {
+		return nil, errors.New("end: must be after start")
+	}
 
 	form := url.Values{}
+	form.Set("event_start", start.UTC().Format(eventTimeLayout))
+	form.Set("event_end", end.UTC().Format(eventTimeLayout))
+	form.Set("event_tz", "UTC")
+
+	return s.setEventTime(ctx, id, form)
+}
+
+// ClearEventTime removes the event times of a post, making it a regular post.
+func (s *PostService) ClearEventTime(ctx context.Context, id string) (*Response, error) {
+	return s.setEventTime(ctx, id, url.Values{})
+}
+
+// Event times are sent without their time zone, which is set separately.
+const eventTimeLayout = "2006-01-02T15:04:05"
+
+func (s *PostService) setEventTime(ctx context.Context, id string, form url.Values) (*Response, error) {
+	path := "api/event_post_time"
+
 	form.Set("api_type", "json")
 	form.Set("id", id)
-	form.Set("state", "false")
 
 	req, err := s.client.NewRequest(http.MethodPost, path, form)
 	if err != nil {
@@ -477,40 +881,16 @@ func (s *PostService) LoadMoreComments(ctx context.Context, pc *PostAndComments)
 	postID := pc.Post.FullID
 	commentIDs := pc.More.Children
 
-	form := url.Values{}
-	form.Set("api_type", "json")
-	form.Set("link_id", postID)
-	form.Set("children", strings.Join(commentIDs, ","))
-
-	path := "api/morechildren"
-
-	// This was originally a GET, but with POST you can send a bigger payload
-	// since it's in the body and not the URI.
-	req, err := s.client.NewRequest(http.MethodPost, path, form)
-	if err != nil {
-		return nil, err
-	}
-
-	root := new(struct {
-		JSON struct {
-			Data struct {
-				Things things `json:"things"`
-			} `json:"data"`
-		} `json:"json"`
-	})
-	resp, err := s.client.Do(ctx, req, root)
+	comments, mores, resp, err := s.moreChildren(ctx, postID, commentIDs)
 	if err != nil {
 		return resp, err
 	}
 
-	comments := root.JSON.Data.Things.Comments
 	for _, c := range comments {
 		pc.addCommentToTree(c)
 	}
 
 	noMore := true
-
-	mores := root.JSON.Data.Things.Mores
 	for _, m := range mores {
 		if strings.HasPrefix(m.ParentID, kindPost+"_") {
 			noMore = false
@@ -525,6 +905,271 @@ func (s *PostService) LoadMoreComments(ctx context.Context, pc *PostAndComments)
 	return resp, nil
 }
 
+// VideoSources are the direct URLs of the streams of a video hosted by Reddit.
+// Reddit serves the video and audio tracks of its videos separately, so they need to be
+// combined (e.g. with ffmpeg) to get a video with sound.
+type VideoSources struct {
+	// Video-only streams, from highest to lowest quality.
+	Video []*VideoStream `json:"video,omitempty"`
+	// Audio-only streams, from highest to lowest quality. Empty if the video has no sound.
+	Audio []*VideoStream `json:"audio,omitempty"`
+	// Video-only MP4 file, which Reddit recommends using as a fallback.
+	FallbackURL string `json:"fallback_url,omitempty"`
+}
+
+// VideoStream is a single stream of a video's DASH manifest.
+type VideoStream struct {
+	URL       string `json:"url,omitempty"`
+	MimeType  string `json:"mime_type,omitempty"`
+	Bandwidth int    `json:"bandwidth"`
+	// Only set for video streams.
+	Width  int `json:"width,omitempty"`
+	Height int `json:"height,omitempty"`
+}
+
+// dashManifest is the subset of a DASH manifest (MPD file) needed to find the streams of a video.
+type dashManifest struct {
+	AdaptationSets []struct {
+		ContentType     string `xml:"contentType,attr"`
+		MimeType        string `xml:"mimeType,attr"`
+		Representations []struct {
+			MimeType  string `xml:"mimeType,attr"`
+			Bandwidth int    `xml:"bandwidth,attr"`
+			Width     int    `xml:"width,attr"`
+			Height    int    `xml:"height,attr"`
+			BaseURL   string `xml:"BaseURL"`
+		} `xml:"Representation"`
+	} `xml:"Period>AdaptationSet"`
+}
+
+// VideoSources resolves the DASH manifest of a post's Reddit-hosted (v.redd.it) video
+// into the direct URLs of its video and audio streams.
+func (s *PostService) VideoSources(ctx context.Context, post *Post) (*VideoSources, *Response, error) {
+	if post == nil {
+		return nil, nil, errors.New("*Post: cannot be nil")
+	}
+
+	var video *RedditVideo
+	if post.SecureMedia != nil && post.SecureMedia.RedditVideo != nil {
+		video = post.SecureMedia.RedditVideo
+	} else if post.Media != nil && post.Media.RedditVideo != nil {
+		video = post.Media.RedditVideo
+	}
+	if video == nil || video.DASHURL == "" {
+		return nil, nil, errors.New("post does not have a video hosted by reddit")
+	}
+
+	// URLs are HTML-escaped in Reddit's JSON responses
+	dashURL, err := url.Parse(html.UnescapeString(video.DASHURL))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// the manifest isn't requested with the client, since it isn't part
+	// of Reddit's API and shouldn't receive its authorization header
+	httpResponse, err := ctxhttp.Get(ctx, nil, dashURL.String())
+	if err != nil {
+		return nil, nil, err
+	}
+	defer httpResponse.Body.Close()
+
+	resp := newResponse(httpResponse)
+	if err := CheckResponse(httpResponse); err != nil {
+		return nil, resp, err
+	}
+
+	manifest := new(dashManifest)
+	if err := xml.NewDecoder(httpResponse.Body).Decode(manifest); err != nil {
+		return nil, resp, err
+	}
+
+	sources := &VideoSources{FallbackURL: html.UnescapeString(video.FallbackURL)}
+	for _, set := range manifest.AdaptationSets {
+		for _, rep := range set.Representations {
+			mimeType := rep.MimeType
+			if mimeType == "" {
+				mimeType = set.MimeType
+			}
+
+			streamURL, err := dashURL.Parse(strings.TrimSpace(rep.BaseURL))
+			if err != nil {
+				return nil, resp, err
+			}
+
+			stream := &VideoStream{
+				URL:       streamURL.String(),
+				MimeType:  mimeType,
+				Bandwidth: rep.Bandwidth,
+				Width:     rep.Width,
+				Height:    rep.Height,
+			}
+
+			if set.ContentType == "audio" || strings.HasPrefix(mimeType, "audio/") {
+				sources.Audio = append(sources.Audio, stream)
+			} else {
+				sources.Video = append(sources.Video, stream)
+			}
+		}
+	}
+
+	sort.SliceStable(sources.Video, func(i, j int) bool { return sources.Video[i].Bandwidth > sources.Video[j].Bandwidth })
+	sort.SliceStable(sources.Audio, func(i, j int) bool { return sources.Audio[i].Bandwidth > sources.Audio[j].Bandwidth })
+
+	return sources, resp, nil
+}
+
+// TreeOptions are options used when loading the full comment tree of a post.
+type TreeOptions struct {
+	// Maximum number of comments to load. If 0, all comments are loaded.
+	// Since comments are loaded in batches of up to 100, the tree can end up with slightly more comments.
+	MaxComments int
+	// Maximum depth of the comments to load, top-level comments having a depth of 1.
+	// If 0, comments are loaded regardless of their depth.
+	MaxDepth int
+	// Maximum number of requests made concurrently. Defaults to 1.
+	Concurrency int
+}
+
+func (o TreeOptions) validate() error {
+	if o.MaxComments < 0 {
+		return errors.New("(TreeOptions).MaxComments: cannot be negative")
+	}
+	if o.MaxDepth < 0 {
+		return errors.New("(TreeOptions).MaxDepth: cannot be negative")
+	}
+	if o.Concurrency < 0 {
+		return errors.New("(TreeOptions).Concurrency: cannot be negative")
+	}
+	return nil
+}
+
+// Maximum number of comments that can be loaded in a single request to api/morechildren.
+const maxMoreChildren = 100
+
+// GetFullCommentTree gets a post and loads its comment tree, repeatedly resolving the "more" nodes
+// of the tree until there are none left, or until the limits of the options are reached.
+// "More" nodes without any children, i.e. "continue this thread" links, are not resolved.
+// id is the ID36 of the post, not its full id.
+// The returned *Response is the one of the last request made.
+func (s *PostService) GetFullCommentTree(ctx context.Context, id string, opts TreeOptions) (*PostAndComments, *Response, error) {
+	if err := opts.validate(); err != nil {
+		return nil, nil, err
+	}
+
+	concurrency := opts.Concurrency
+	if concurrency == 0 {
+		concurrency = 1
+	}
+
+	pc, resp, err := s.Get(ctx, id, nil)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	type result struct {
+		comments []*Comment
+		mores    []*More
+		resp     *Response
+		err      error
+	}
+
+	for {
+		if opts.MaxComments > 0 && pc.numComments() >= opts.MaxComments {
+			break
+		}
+
+		mores := pc.moreNodes(opts.MaxDepth)
+		if len(mores) == 0 {
+			break
+		}
+
+		// only fetch as many chunks as needed to reach the maximum number of comments
+		maxChunks := -1
+		if opts.MaxComments > 0 {
+			maxChunks = (opts.MaxComments - pc.numComments() + maxMoreChildren - 1) / maxMoreChildren
+		}
+
+		// fetch the children of every node concurrently, then add them
+		// to the tree once all of them are in, since it isn't thread-safe
+		var chunks [][]string
+		var owners []*More
+		fetched := make(map[*More]int)
+	collect:
+		for _, more := range mores {
+			for i := 0; i < len(more.Children); i += maxMoreChildren {
+				if len(chunks) == maxChunks {
+					break collect
+				}
+
+				end := i + maxMoreChildren
+				if end > len(more.Children) {
+					end = len(more.Children)
+				}
+				chunks = append(chunks, more.Children[i:end])
+				owners = append(owners, more)
+				fetched[more] = end
+			}
+		}
+
+		results := make([]result, len(chunks))
+		sem := make(chan struct{}, concurrency)
+		var wg sync.WaitGroup
+
+		for i, chunk := range chunks {
+			wg.Add(1)
+			go func(i int, chunk []string) {
+				defer wg.Done()
+
+				sem <- struct{}{}
+				defer func() { <-sem }()
+
+				r := &results[i]
+				r.comments, r.mores, r.resp, r.err = s.moreChildren(ctx, pc.Post.FullID, chunk)
+			}(i, chunk)
+		}
+		wg.Wait()
+
+		for _, r := range results {
+			if r.err != nil {
+				return nil, r.resp, r.err
+			}
+		}
+
+		// nodes whose children were only partly fetched keep the rest of them
+		partial := make(map[string]*More)
+		for more, end := range fetched {
+			if end == len(more.Children) {
+				pc.removeMoreFromTree(more)
+				continue
+			}
+			more.Children = more.Children[end:]
+			partial[more.ParentID] = more
+		}
+
+		before := pc.numComments()
+		for _, r := range results {
+			resp = r.resp
+			for _, c := range r.comments {
+				pc.addCommentToTree(c)
+			}
+			for _, m := range r.mores {
+				if more, ok := partial[m.ParentID]; ok {
+					more.Children = append(more.Children, m.Children...)
+					continue
+				}
+				pc.addMoreToTree(m)
+			}
+		}
+
+		// guard against looping forever if reddit keeps returning the same nodes
+		if pc.numComments() == before {
+			break
+		}
+	}
+
+	return pc, resp, nil
+}
+
 func (s *PostService) random(ctx context.Context, subreddits ...string) (*PostAndComments, *Response, error) {
 	path := "random"
 	if len(subreddits) > 0 {
@@ -1,13 +1,20 @@
 package reddit
 
 import (
+	"bytes"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"net/http"
 	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
+	"golang.org/x/net/websocket"
 )
 
 var expectedPostAndComments = &PostAndComments{
@@ -15,7 +22,7 @@ var expectedPostAndComments = &PostAndComments{
 		ID:      "testpost",
 		FullID:  "t3_testpost",
 		Created: &Timestamp{time.Date(2020, 7, 18, 10, 26, 7, 0, time.UTC)},
-		Edited:  &Timestamp{time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)},
+		Edited:  &Edited{},
 
 		Permalink: "/r/test/comments/testpost/test/",
 		URL:       "https://www.reddit.com/r/test/comments/testpost/test/",
@@ -36,13 +43,15 @@ var expectedPostAndComments = &PostAndComments{
 		AuthorID: "t2_testuser",
 
 		IsSelfPost: true,
+
+		AllAwardings: []*Award{},
 	},
 	Comments: []*Comment{
 		{
 			ID:      "testc1",
 			FullID:  "t1_testc1",
 			Created: &Timestamp{time.Date(2020, 7, 18, 10, 31, 59, 0, time.UTC)},
-			Edited:  &Timestamp{time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)},
+			Edited:  &Edited{},
 
 			ParentID:  "t3_testpost",
 			Permalink: "/r/test/comments/testpost/test/testc1/",
@@ -69,7 +78,7 @@ var expectedPostAndComments = &PostAndComments{
 						ID:      "testc2",
 						FullID:  "t1_testc2",
 						Created: &Timestamp{time.Date(2020, 7, 18, 10, 32, 28, 0, time.UTC)},
-						Edited:  &Timestamp{time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)},
+						Edited:  &Edited{},
 
 						ParentID:  "t1_testc1",
 						Permalink: "/r/test/comments/testpost/test/testc2/",
@@ -106,7 +115,7 @@ var expectedEditedPost = &Post{
 	ID:      "hw6l6a",
 	FullID:  "t3_hw6l6a",
 	Created: &Timestamp{time.Date(2020, 7, 23, 1, 24, 55, 0, time.UTC)},
-	Edited:  &Timestamp{time.Date(2020, 7, 23, 1, 42, 44, 0, time.UTC)},
+	Edited:  NewEdited(time.Date(2020, 7, 23, 1, 42, 44, 0, time.UTC)),
 
 	Permalink: "/r/test/comments/hw6l6a/test_title/",
 	URL:       "https://www.reddit.com/r/test/comments/hw6l6a/test_title/",
@@ -130,13 +139,15 @@ var expectedEditedPost = &Post{
 
 	Spoiler:    true,
 	IsSelfPost: true,
+
+	AllAwardings: []*Award{},
 }
 
 var expectedPost2 = &Post{
 	ID:      "i2gvs1",
 	FullID:  "t3_i2gvs1",
 	Created: &Timestamp{time.Date(2020, 8, 2, 18, 23, 37, 0, time.UTC)},
-	Edited:  &Timestamp{time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)},
+	Edited:  &Edited{},
 
 	Permalink: "/r/test/comments/i2gvs1/this_is_a_title/",
 	URL:       "http://example.com",
@@ -156,6 +167,8 @@ var expectedPost2 = &Post{
 
 	Author:   "v_95",
 	AuthorID: "t2_164ab8",
+
+	AllAwardings: []*Award{},
 }
 
 var expectedPostDuplicates = []*Post{
@@ -163,7 +176,7 @@ var expectedPostDuplicates = []*Post{
 		ID:      "8kbs85",
 		FullID:  "t3_8kbs85",
 		Created: &Timestamp{time.Date(2018, 5, 18, 9, 10, 18, 0, time.UTC)},
-		Edited:  &Timestamp{time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)},
+		Edited:  &Edited{},
 
 		Permalink: "/r/test/comments/8kbs85/test/",
 		URL:       "http://example.com",
@@ -183,12 +196,14 @@ var expectedPostDuplicates = []*Post{
 
 		Author:   "GarlicoinAccount",
 		AuthorID: "t2_d2v1r90",
+
+		AllAwardings: []*Award{},
 	},
 	{
 		ID:      "le1tc",
 		FullID:  "t3_le1tc",
 		Created: &Timestamp{time.Date(2011, 10, 16, 13, 26, 40, 0, time.UTC)},
-		Edited:  &Timestamp{time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)},
+		Edited:  &Edited{},
 
 		Permalink: "/r/test/comments/le1tc/test_to_see_if_this_fixes_the_problem_of_my_likes/",
 		URL:       "http://www.example.com",
@@ -208,6 +223,8 @@ var expectedPostDuplicates = []*Post{
 
 		Author:   "prog101",
 		AuthorID: "t2_8dyo",
+
+		AllAwardings: []*Award{},
 	},
 }
 
@@ -219,10 +236,24 @@ func TestPostService_Get(t *testing.T) {
 
 	mux.HandleFunc("/comments/abc123", func(w http.ResponseWriter, r *http.Request) {
 		require.Equal(t, http.MethodGet, r.Method)
+
+		form := url.Values{}
+		form.Set("limit", "50")
+		form.Set("depth", "3")
+		form.Set("sort", "new")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.Form)
+
 		fmt.Fprint(w, blob)
 	})
 
-	postAndComments, _, err := client.Post.Get(ctx, "abc123")
+	postAndComments, _, err := client.Post.Get(ctx, "abc123", &ListPostCommentsOptions{
+		Limit: 50,
+		Depth: 3,
+		Sort:  CommentSortNew,
+	})
 	require.NoError(t, err)
 	require.Equal(t, expectedPostAndComments, postAndComments)
 }
@@ -259,6 +290,21 @@ func TestPostService_Duplicates(t *testing.T) {
 	require.Equal(t, "t3_le1tc", resp.After)
 }
 
+func TestPostService_Duplicates_NotFound(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/duplicates/abc123", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, `[
+			{"kind": "Listing", "data": {"children": []}},
+			{"kind": "Listing", "data": {"children": []}}
+		]`)
+	})
+
+	_, _, _, err := client.Post.Duplicates(ctx, "abc123", nil)
+	require.EqualError(t, err, `cannot find post with id "abc123"`)
+}
+
 func TestPostService_SubmitText(t *testing.T) {
 	client, mux := setup(t)
 
@@ -283,6 +329,19 @@ func TestPostService_SubmitText(t *testing.T) {
 		fmt.Fprint(w, blob)
 	})
 
+	_, _, err = client.Post.SubmitText(ctx, SubmitTextRequest{Title: "Test Title"})
+	require.EqualError(t, err, "(SubmitTextRequest).Subreddit: cannot be empty")
+
+	_, _, err = client.Post.SubmitText(ctx, SubmitTextRequest{Subreddit: "test"})
+	require.EqualError(t, err, "(SubmitTextRequest).Title: must be between 1-300 characters")
+
+	_, _, err = client.Post.SubmitText(ctx, SubmitTextRequest{Subreddit: "test", Title: strings.Repeat("x", 301)})
+	require.EqualError(t, err, "(SubmitTextRequest).Title: must be between 1-300 characters")
+
+	// the limit is in characters, not bytes
+	err = SubmitTextRequest{Subreddit: "test", Title: strings.Repeat("é", 300)}.validate()
+	require.NoError(t, err)
+
 	submittedPost, _, err := client.Post.SubmitText(ctx, SubmitTextRequest{
 		Subreddit: "test",
 		Title:     "Test Title",
@@ -331,6 +390,307 @@ func TestPostService_SubmitLink(t *testing.T) {
 	require.Equal(t, expectedSubmittedPost, submittedPost)
 }
 
+func TestPostService_SubmitLink_AlreadySubmitted(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/listings/posts.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/api/submit", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		fmt.Fprint(w, `{
+			"json": {
+				"errors": [
+					[
+						"ALREADY_SUB",
+						"that link has already been submitted",
+						"url"
+					]
+				]
+			}
+		}`)
+	})
+
+	mux.HandleFunc("/api/info", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, "http://example.com", r.Form.Get("url"))
+
+		fmt.Fprint(w, blob)
+	})
+
+	_, _, err = client.Post.SubmitLink(ctx, SubmitLinkRequest{Title: "Test Title", URL: "http://example.com"})
+	require.EqualError(t, err, "(SubmitLinkRequest).Subreddit: cannot be empty")
+
+	_, _, err = client.Post.SubmitLink(ctx, SubmitLinkRequest{Subreddit: "test", URL: "http://example.com"})
+	require.EqualError(t, err, "(SubmitLinkRequest).Title: must be between 1-300 characters")
+
+	_, _, err = client.Post.SubmitLink(ctx, SubmitLinkRequest{Subreddit: "test", Title: "Test Title"})
+	require.EqualError(t, err, "(SubmitLinkRequest).URL: cannot be empty")
+
+	_, _, err = client.Post.SubmitLink(ctx, SubmitLinkRequest{
+		Subreddit: "test",
+		Title:     "Test Title",
+		URL:       "http://example.com",
+	})
+	require.IsType(t, &AlreadySubmittedError{}, err)
+
+	alreadySubmittedErr := err.(*AlreadySubmittedError)
+	require.Equal(t, expectedListingPosts2, alreadySubmittedErr.Posts)
+}
+
+func TestPostService_SubmitImage(t *testing.T) {
+	client, mux := setup(t)
+
+	uploadURL := client.BaseURL.Host + "/api/media_upload"
+
+	leaseBlob, err := readFileContents("../testdata/post/media-lease.json")
+	require.NoError(t, err)
+	leaseBlob = fmt.Sprintf(leaseBlob, uploadURL)
+
+	submitBlob, err := readFileContents("../testdata/post/submit-media.json")
+	require.NoError(t, err)
+
+	imageFile, err := ioutil.TempFile("/tmp", "image*.png")
+	require.NoError(t, err)
+	defer func() {
+		imageFile.Close()
+		os.Remove(imageFile.Name())
+	}()
+
+	_, err = imageFile.WriteString("this is a test")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/api/media/asset.json", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("filepath", filepath.Base(imageFile.Name()))
+		form.Set("mimetype", "image/png")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+
+		fmt.Fprint(w, leaseBlob)
+	})
+
+	mux.HandleFunc("/api/media_upload", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		_, file, err := r.FormFile("file")
+		require.NoError(t, err)
+
+		rdr, err := file.Open()
+		require.NoError(t, err)
+
+		buf := new(bytes.Buffer)
+		_, err = io.Copy(buf, rdr)
+		require.NoError(t, err)
+		require.Equal(t, "this is a test", buf.String())
+
+		form := url.Values{}
+		form.Set("key", "rte_images/abc123")
+		form.Set("test name", "test value")
+
+		// for some reason this has to come after the FormFile call
+		err = r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+	})
+
+	mux.HandleFunc("/api/submit", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("api_type", "json")
+		form.Set("kind", "image")
+		form.Set("sr", "test")
+		form.Set("title", "Test Title")
+		form.Set("url", fmt.Sprintf("http://%s/rte_images/abc123", uploadURL))
+		form.Set("nsfw", "true")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+
+		fmt.Fprint(w, submitBlob)
+	})
+
+	_, _, err = client.Post.SubmitImage(ctx, SubmitImageRequest{Subreddit: "test", Title: "Test Title"})
+	require.EqualError(t, err, "(SubmitImageRequest).ImagePath: cannot be empty")
+
+	_, _, err = client.Post.SubmitImage(ctx, SubmitImageRequest{Subreddit: "test", Title: "Test Title", ImagePath: "image.bmp"})
+	require.EqualError(t, err, `unsupported image file type: ".bmp"`)
+
+	_, _, err = client.Post.SubmitImage(ctx, SubmitImageRequest{Subreddit: "test", Title: "Test Title", ImagePath: "video.mp4"})
+	require.EqualError(t, err, `unsupported image file type: ".mp4"`)
+
+	submittedPost, _, err := client.Post.SubmitImage(ctx, SubmitImageRequest{
+		Subreddit: "test",
+		Title:     "Test Title",
+		ImagePath: imageFile.Name(),
+		NSFW:      true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, &Submitted{WebSocketURL: "wss://ws-test.wss.redditmedia.com/rte_images/abc123?m=test"}, submittedPost)
+}
+
+func TestPostService_SubmitVideo(t *testing.T) {
+	client, mux := setup(t)
+
+	uploadURL := client.BaseURL.Host + "/api/media_upload"
+
+	leaseBlob, err := readFileContents("../testdata/post/media-lease.json")
+	require.NoError(t, err)
+	leaseBlob = fmt.Sprintf(leaseBlob, uploadURL)
+
+	submitBlob, err := readFileContents("../testdata/post/submit-media.json")
+	require.NoError(t, err)
+
+	videoFile, err := ioutil.TempFile("/tmp", "video*.mp4")
+	require.NoError(t, err)
+	defer func() {
+		videoFile.Close()
+		os.Remove(videoFile.Name())
+	}()
+
+	thumbnailFile, err := ioutil.TempFile("/tmp", "thumbnail*.jpg")
+	require.NoError(t, err)
+	defer func() {
+		thumbnailFile.Close()
+		os.Remove(thumbnailFile.Name())
+	}()
+
+	var uploaded []string
+	mux.HandleFunc("/api/media/asset.json", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		uploaded = append(uploaded, r.PostForm.Get("mimetype"))
+
+		fmt.Fprint(w, leaseBlob)
+	})
+
+	mux.HandleFunc("/api/media_upload", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+	})
+
+	mux.HandleFunc("/api/submit", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("api_type", "json")
+		form.Set("kind", "videogif")
+		form.Set("sr", "test")
+		form.Set("title", "Test Title")
+		form.Set("url", fmt.Sprintf("http://%s/rte_images/abc123", uploadURL))
+		form.Set("video_poster_url", fmt.Sprintf("http://%s/rte_images/abc123", uploadURL))
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+
+		fmt.Fprint(w, submitBlob)
+	})
+
+	_, _, err = client.Post.SubmitVideo(ctx, SubmitVideoRequest{Subreddit: "test", Title: "Test Title"})
+	require.EqualError(t, err, "(SubmitVideoRequest).VideoPath: cannot be empty")
+
+	_, _, err = client.Post.SubmitVideo(ctx, SubmitVideoRequest{Subreddit: "test", Title: "Test Title", VideoPath: "image.png"})
+	require.EqualError(t, err, `unsupported video file type: ".png"`)
+
+	_, _, err = client.Post.SubmitVideo(ctx, SubmitVideoRequest{Subreddit: "test", Title: "Test Title", VideoPath: "video.mp4", ThumbnailPath: "thumbnail.mov"})
+	require.EqualError(t, err, `unsupported image file type: ".mov"`)
+
+	submittedPost, _, err := client.Post.SubmitVideo(ctx, SubmitVideoRequest{
+		Subreddit:     "test",
+		Title:         "Test Title",
+		VideoPath:     videoFile.Name(),
+		ThumbnailPath: thumbnailFile.Name(),
+		VideoGIF:      true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, &Submitted{WebSocketURL: "wss://ws-test.wss.redditmedia.com/rte_images/abc123?m=test"}, submittedPost)
+	require.Equal(t, []string{"video/mp4", "image/jpeg"}, uploaded)
+}
+
+func TestPostService_WaitForSubmission(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.Handle("/ws/success", websocket.Handler(func(conn *websocket.Conn) {
+		websocket.Message.Send(conn, `{"type": "ping"}`)
+		websocket.Message.Send(conn, `{"type": "success", "payload": {"redirect": "https://www.reddit.com/r/test/comments/abc123/test_title/"}}`)
+	}))
+
+	mux.Handle("/ws/failed", websocket.Handler(func(conn *websocket.Conn) {
+		websocket.Message.Send(conn, `{"type": "failed"}`)
+	}))
+
+	wsURL := "ws://" + client.BaseURL.Host
+
+	submitted, err := client.Post.WaitForSubmission(ctx, &Submitted{ID: "abc123"})
+	require.NoError(t, err)
+	require.Equal(t, &Submitted{ID: "abc123"}, submitted)
+
+	submitted, err = client.Post.WaitForSubmission(ctx, &Submitted{WebSocketURL: wsURL + "/ws/success"})
+	require.NoError(t, err)
+	require.Equal(t, &Submitted{
+		ID:     "abc123",
+		FullID: "t3_abc123",
+		URL:    "https://www.reddit.com/r/test/comments/abc123/test_title/",
+	}, submitted)
+
+	_, err = client.Post.WaitForSubmission(ctx, &Submitted{WebSocketURL: wsURL + "/ws/failed"})
+	require.EqualError(t, err, "reddit failed to process the submitted media")
+}
+
+func TestPostService_VideoSources(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/post/dash.mpd")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/abc123/DASHPlaylist.mpd", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		require.Empty(t, r.Header.Get("Authorization"))
+		require.Equal(t, "a=1&f=sd", r.URL.RawQuery)
+		fmt.Fprint(w, blob)
+	})
+
+	_, _, err = client.Post.VideoSources(ctx, nil)
+	require.EqualError(t, err, "*Post: cannot be nil")
+
+	_, _, err = client.Post.VideoSources(ctx, &Post{})
+	require.EqualError(t, err, "post does not have a video hosted by reddit")
+
+	videoURL := client.BaseURL.String() + "/abc123/"
+	post := &Post{
+		SecureMedia: &PostMedia{
+			RedditVideo: &RedditVideo{
+				FallbackURL: videoURL + "DASH_720.mp4?source=fallback",
+				DASHURL:     videoURL + "DASHPlaylist.mpd?a=1&amp;f=sd",
+			},
+		},
+	}
+
+	sources, _, err := client.Post.VideoSources(ctx, post)
+	require.NoError(t, err)
+	require.Equal(t, &VideoSources{
+		Video: []*VideoStream{
+			{URL: videoURL + "DASH_720.mp4", MimeType: "video/mp4", Bandwidth: 4798208, Width: 1280, Height: 720},
+			{URL: videoURL + "DASH_360.mp4", MimeType: "video/mp4", Bandwidth: 1204608, Width: 640, Height: 360},
+		},
+		Audio: []*VideoStream{
+			{URL: videoURL + "DASH_audio.mp4", MimeType: "audio/mp4", Bandwidth: 130838},
+		},
+		FallbackURL: videoURL + "DASH_720.mp4?source=fallback",
+	}, sources)
+}
+
 func TestPostService_Edit(t *testing.T) {
 	client, mux := setup(t)
 
@@ -375,6 +735,9 @@ func TestPostService_Hide(t *testing.T) {
 	_, err := client.Post.Hide(ctx)
 	require.EqualError(t, err, "must provide at least 1 id")
 
+	_, err = client.Post.Hide(ctx, make([]string, 51)...)
+	require.EqualError(t, err, "cannot provide more than 50 ids")
+
 	resp, err := client.Post.Hide(ctx, "1", "2", "3")
 	require.NoError(t, err)
 	require.Equal(t, http.StatusOK, resp.StatusCode)
@@ -397,6 +760,9 @@ func TestPostService_Unhide(t *testing.T) {
 	_, err := client.Post.Unhide(ctx)
 	require.EqualError(t, err, "must provide at least 1 id")
 
+	_, err = client.Post.Unhide(ctx, make([]string, 51)...)
+	require.EqualError(t, err, "cannot provide more than 50 ids")
+
 	resp, err := client.Post.Unhide(ctx, "1", "2", "3")
 	require.NoError(t, err)
 	require.Equal(t, http.StatusOK, resp.StatusCode)
@@ -796,6 +1162,76 @@ func TestPostService_DisableContestMode(t *testing.T) {
 	require.Equal(t, http.StatusOK, resp.StatusCode)
 }
 
+func TestPostService_SetCrowdControlLevel(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/api/update_crowd_control_level", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("api_type", "json")
+		form.Set("id", "t3_test")
+		form.Set("level", "2")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+	})
+
+	_, err := client.Post.SetCrowdControlLevel(ctx, "t3_test", 4)
+	require.EqualError(t, err, "level: must be between 0 and 3")
+
+	_, err = client.Post.SetCrowdControlLevel(ctx, "t3_test", 2)
+	require.NoError(t, err)
+}
+
+func TestPostService_SetEventTime(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/api/event_post_time", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("api_type", "json")
+		form.Set("id", "t3_test")
+		form.Set("event_start", "2020-10-01T18:00:00")
+		form.Set("event_end", "2020-10-01T20:30:00")
+		form.Set("event_tz", "UTC")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+	})
+
+	start := time.Date(2020, 10, 1, 14, 0, 0, 0, time.FixedZone("EDT", -4*60*60))
+	end := start.Add(150 * time.Minute)
+
+	_, err := client.Post.SetEventTime(ctx, "t3_test", end, start)
+	require.EqualError(t, err, "end: must be after start")
+
+	_, err = client.Post.SetEventTime(ctx, "t3_test", start, end)
+	require.NoError(t, err)
+}
+
+func TestPostService_ClearEventTime(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/api/event_post_time", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("api_type", "json")
+		form.Set("id", "t3_test")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+	})
+
+	_, err := client.Post.ClearEventTime(ctx, "t3_test")
+	require.NoError(t, err)
+}
+
 func TestPostService_LoadMoreReplies(t *testing.T) {
 	client, mux := setup(t)
 
@@ -847,6 +1283,125 @@ func TestPostService_LoadMoreReplies(t *testing.T) {
 	require.Len(t, pc.Comments[0].Replies.Comments[0].Replies.Comments, 1)
 }
 
+func TestPostService_GetFullCommentTree(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/comments/abc123", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, `[
+			{"kind": "Listing", "data": {"children": [
+				{"kind": "t3", "data": {"id": "abc123", "name": "t3_abc123"}}
+			]}},
+			{"kind": "Listing", "data": {"children": [
+				{"kind": "t1", "data": {"id": "a", "name": "t1_a", "parent_id": "t3_abc123", "replies": ""}},
+				{"kind": "more", "data": {"id": "b", "name": "t1_b", "parent_id": "t3_abc123", "children": ["b", "c"]}}
+			]}}
+		]`)
+	})
+
+	var requests []string
+	mux.HandleFunc("/api/morechildren", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, "t3_abc123", r.PostForm.Get("link_id"))
+
+		children := r.PostForm.Get("children")
+		requests = append(requests, children)
+
+		switch children {
+		case "b,c":
+			fmt.Fprint(w, `{"json": {"data": {"things": [
+				{"kind": "t1", "data": {"id": "b", "name": "t1_b", "parent_id": "t3_abc123", "replies": ""}},
+				{"kind": "t1", "data": {"id": "c", "name": "t1_c", "parent_id": "t1_b", "replies": ""}},
+				{"kind": "more", "data": {"id": "d", "name": "t1_d", "parent_id": "t1_c", "children": ["d"]}}
+			]}}}`)
+		case "d":
+			fmt.Fprint(w, `{"json": {"data": {"things": [
+				{"kind": "t1", "data": {"id": "d", "name": "t1_d", "parent_id": "t1_c", "replies": ""}}
+			]}}}`)
+		default:
+			t.Fatalf("unexpected children: %s", children)
+		}
+	})
+
+	_, _, err := client.Post.GetFullCommentTree(ctx, "abc123", TreeOptions{Concurrency: -1})
+	require.EqualError(t, err, "(TreeOptions).Concurrency: cannot be negative")
+
+	pc, _, err := client.Post.GetFullCommentTree(ctx, "abc123", TreeOptions{Concurrency: 2})
+	require.NoError(t, err)
+	require.Equal(t, []string{"b,c", "d"}, requests)
+	require.False(t, pc.HasMore())
+	require.Equal(t, 4, pc.numComments())
+	require.Equal(t, "t1_d", pc.Comments[1].Replies.Comments[0].Replies.Comments[0].FullID)
+
+	requests = nil
+	pc, _, err = client.Post.GetFullCommentTree(ctx, "abc123", TreeOptions{MaxDepth: 2})
+	require.NoError(t, err)
+	require.Equal(t, []string{"b,c"}, requests)
+	require.Equal(t, 3, pc.numComments())
+	require.True(t, pc.Comments[1].Replies.Comments[0].HasMore())
+
+	requests = nil
+	pc, _, err = client.Post.GetFullCommentTree(ctx, "abc123", TreeOptions{MaxComments: 1})
+	require.NoError(t, err)
+	require.Empty(t, requests)
+	require.Equal(t, 1, pc.numComments())
+}
+
+func TestPostService_GetFullCommentTree_MaxComments(t *testing.T) {
+	client, mux := setup(t)
+
+	children := make([]string, 250)
+	for i := range children {
+		children[i] = fmt.Sprintf("c%d", i)
+	}
+
+	mux.HandleFunc("/comments/abc123", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprintf(w, `[
+			{"kind": "Listing", "data": {"children": [
+				{"kind": "t3", "data": {"id": "abc123", "name": "t3_abc123"}}
+			]}},
+			{"kind": "Listing", "data": {"children": [
+				{"kind": "more", "data": {"id": "c0", "name": "t1_c0", "parent_id": "t3_abc123", "children": ["%s"]}}
+			]}}
+		]`, strings.Join(children, `", "`))
+	})
+
+	var requests []string
+	mux.HandleFunc("/api/morechildren", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+
+		ids := strings.Split(r.PostForm.Get("children"), ",")
+		requests = append(requests, r.PostForm.Get("children"))
+
+		things := make([]string, 0, len(ids))
+		for _, id := range ids {
+			things = append(things, fmt.Sprintf(`{"kind": "t1", "data": {"id": "%s", "name": "t1_%s", "parent_id": "t3_abc123", "replies": ""}}`, id, id))
+		}
+		fmt.Fprintf(w, `{"json": {"data": {"things": [%s]}}}`, strings.Join(things, ","))
+	})
+
+	pc, _, err := client.Post.GetFullCommentTree(ctx, "abc123", TreeOptions{MaxComments: 10})
+	require.NoError(t, err)
+	require.Equal(t, []string{strings.Join(children[:100], ",")}, requests)
+	require.Equal(t, 100, pc.numComments())
+	require.True(t, pc.HasMore())
+	require.Equal(t, children[100:], pc.More.Children)
+
+	requests = nil
+	pc, _, err = client.Post.GetFullCommentTree(ctx, "abc123", TreeOptions{MaxComments: 150})
+	require.NoError(t, err)
+	require.Len(t, requests, 2)
+	require.Equal(t, 200, pc.numComments())
+	require.Equal(t, children[200:], pc.More.Children)
+}
+
 func TestPostService_RandomFromSubreddits(t *testing.T) {
 	client, mux := setup(t)
 
@@ -933,6 +1488,26 @@ func TestPostService_Save(t *testing.T) {
 	require.Equal(t, http.StatusOK, resp.StatusCode)
 }
 
+func TestPostService_SaveToCategory(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/api/save", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("id", "t3_test")
+		form.Set("category", "testcategory")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+	})
+
+	resp, err := client.Post.SaveToCategory(ctx, "t3_test", "testcategory")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
 func TestPostService_Unsave(t *testing.T) {
 	client, mux := setup(t)
 
@@ -1030,6 +1605,30 @@ func TestPostService_Unlock(t *testing.T) {
 	require.Equal(t, http.StatusOK, resp.StatusCode)
 }
 
+func TestPostService_Vote(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/api/vote", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("id", "t3_test")
+		form.Set("dir", "-1")
+		form.Set("rank", "10")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+	})
+
+	_, err := client.Post.Vote(ctx, "t3_test", Vote(2))
+	require.EqualError(t, err, "vote: must be one of -1, 0, 1")
+
+	resp, err := client.Post.Vote(ctx, "t3_test", VoteDown)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
 func TestPostService_Upvote(t *testing.T) {
 	client, mux := setup(t)
 
@@ -1133,3 +1732,87 @@ func TestPostService_Report(t *testing.T) {
 	_, err := client.Post.Report(ctx, "t3_test", "test reason")
 	require.NoError(t, err)
 }
+
+func TestPostService_ReportWithOptions(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/api/report", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("api_type", "json")
+		form.Set("thing_id", "t3_test")
+		form.Set("rule_reason", "Read the Rules Before Posting")
+		form.Set("other_reason", "test reason")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+	})
+
+	_, err := client.Post.ReportWithOptions(ctx, "t3_test", ReportOptions{})
+	require.EqualError(t, err, "ReportOptions: must provide at least 1 reason")
+
+	_, err = client.Post.ReportWithOptions(ctx, "t3_test", ReportOptions{FreeText: strings.Repeat("x", 101)})
+	require.EqualError(t, err, "ReportOptions: reasons cannot be longer than 100 characters")
+
+	// the limit is in characters, not bytes
+	err = ReportOptions{FreeText: strings.Repeat("é", 100)}.validate()
+	require.NoError(t, err)
+
+	_, err = client.Post.ReportWithOptions(ctx, "t3_test", ReportOptions{
+		RuleReason: "Read the Rules Before Posting",
+		FreeText:   "test reason",
+	})
+	require.NoError(t, err)
+}
+
+func TestPost_GalleryImages(t *testing.T) {
+	post := &Post{}
+	require.Nil(t, post.GalleryImages(0))
+
+	post = &Post{
+		GalleryData: &GalleryData{
+			Items: []*GalleryItem{
+				{ID: 2, MediaID: "def456"},
+				{ID: 1, MediaID: "abc123", Caption: "first caption", OutboundURL: "https://example.com"},
+				{ID: 3, MediaID: "missing"},
+			},
+		},
+		MediaMetadata: map[string]*MediaMetadata{
+			"abc123": {
+				ID:     "abc123",
+				Status: "valid",
+				Kind:   "Image",
+				Source: &MediaSource{URL: "https://preview.redd.it/abc123.jpg?width=1920&amp;s=1", Width: 1920, Height: 1080},
+				Previews: []*MediaSource{
+					{URL: "https://preview.redd.it/abc123.jpg?width=108&amp;s=2", Width: 108, Height: 60},
+					{URL: "https://preview.redd.it/abc123.jpg?width=640&amp;s=3", Width: 640, Height: 360},
+					{URL: "https://preview.redd.it/abc123.jpg?width=960&amp;s=4", Width: 960, Height: 540},
+				},
+			},
+			"def456": {
+				ID:     "def456",
+				Status: "valid",
+				Kind:   "AnimatedImage",
+				Source: &MediaSource{GIF: "https://i.redd.it/def456.gif", Width: 500, Height: 500},
+			},
+		},
+	}
+
+	require.Equal(t, []*GalleryImage{
+		{MediaID: "def456", URL: "https://i.redd.it/def456.gif", Width: 500, Height: 500},
+		{MediaID: "abc123", Caption: "first caption", OutboundURL: "https://example.com", URL: "https://preview.redd.it/abc123.jpg?width=1920&s=1", Width: 1920, Height: 1080},
+	}, post.GalleryImages(0))
+
+	require.Equal(t, []*GalleryImage{
+		{MediaID: "def456", URL: "https://i.redd.it/def456.gif", Width: 500, Height: 500},
+		{MediaID: "abc123", Caption: "first caption", OutboundURL: "https://example.com", URL: "https://preview.redd.it/abc123.jpg?width=640&s=3", Width: 640, Height: 360},
+	}, post.GalleryImages(320))
+}
+
+func TestPost_Vote(t *testing.T) {
+	require.Equal(t, VoteNone, (&Post{}).Vote())
+	require.Equal(t, VoteUp, (&Post{Likes: Bool(true)}).Vote())
+	require.Equal(t, VoteDown, (&Post{Likes: Bool(false)}).Vote())
+}
@@ -0,0 +1,112 @@
+package reddit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Maximum number of IDs that can be looked up in a single request.
+const maxBatchSize = 100
+
+type batchFetchFunc func(ctx context.Context, ids []string) (map[string]interface{}, *Response, error)
+
+// batcher coalesces lookups of IDs that are made within a short window of time,
+// possibly across goroutines, into as few requests as possible.
+type batcher struct {
+	window time.Duration
+	fetch  batchFetchFunc
+
+	mu      sync.Mutex
+	pending *batch
+}
+
+type batch struct {
+	ids  []string
+	seen map[string]bool
+
+	once sync.Once
+	done chan struct{}
+
+	// These are set before done is closed.
+	values map[string]interface{}
+	resp   *Response
+	err    error
+}
+
+func newBatcher(window time.Duration, fetch batchFetchFunc) *batcher {
+	return &batcher{window: window, fetch: fetch}
+}
+
+// Lookup adds the IDs to the pending batch and waits for it to be fetched.
+// If there are too many IDs to fit in a single batch, they are spread across multiple.
+// The values are keyed by ID; IDs that could not be found are absent from the map.
+// All callers sharing a batch receive the same *Response.
+func (b *batcher) Lookup(ctx context.Context, ids []string) (map[string]interface{}, *Response, error) {
+	var batches []*batch
+
+	b.mu.Lock()
+	for _, id := range ids {
+		if b.pending == nil {
+			bt := &batch{seen: make(map[string]bool), done: make(chan struct{})}
+			b.pending = bt
+			time.AfterFunc(b.window, func() { b.flush(bt) })
+		}
+
+		bt := b.pending
+		if len(batches) == 0 || batches[len(batches)-1] != bt {
+			batches = append(batches, bt)
+		}
+
+		if bt.seen[id] {
+			continue
+		}
+		bt.seen[id] = true
+		bt.ids = append(bt.ids, id)
+
+		if len(bt.ids) == maxBatchSize {
+			b.pending = nil
+			go b.flush(bt)
+		}
+	}
+	b.mu.Unlock()
+
+	values := make(map[string]interface{})
+	var resp *Response
+
+	for _, bt := range batches {
+		select {
+		case <-ctx.Done():
+			return nil, resp, ctx.Err()
+		case <-bt.done:
+		}
+
+		resp = bt.resp
+		if bt.err != nil {
+			return nil, resp, bt.err
+		}
+
+		for _, id := range ids {
+			if v, ok := bt.values[id]; ok {
+				values[id] = v
+			}
+		}
+	}
+
+	return values, resp, nil
+}
+
+// flush fetches the batch. The request isn't tied to the context of
+// any of the callers, since the batch is shared between all of them.
+func (b *batcher) flush(bt *batch) {
+	bt.once.Do(func() {
+		b.mu.Lock()
+		if b.pending == bt {
+			b.pending = nil
+		}
+		b.mu.Unlock()
+
+		bt.values, bt.resp, bt.err = b.fetch(context.Background(), bt.ids)
+		close(bt.done)
+	})
+}
@@ -0,0 +1,138 @@
+package reddit
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserService_GetMultipleByID_Batched(t *testing.T) {
+	client, mux := setup(t)
+
+	err := WithBatchWindow(time.Millisecond * 50)(client)
+	require.NoError(t, err)
+
+	blob, err := readFileContents("../testdata/user/get-multiple-by-id.json")
+	require.NoError(t, err)
+
+	var counter int
+	mux.HandleFunc("/api/user_data_by_account_ids", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		counter++
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+
+		ids := strings.Split(r.Form.Get("ids"), ",")
+		sort.Strings(ids)
+		require.Equal(t, []string{"t2_1", "t2_2", "t2_3"}, ids)
+
+		fmt.Fprint(w, blob)
+	})
+
+	var wg sync.WaitGroup
+	results := make([]map[string]*UserSummary, 3)
+
+	for i, ids := range [][]string{{"t2_1"}, {"t2_2", "t2_3"}, {"t2_1", "t2_3"}} {
+		wg.Add(1)
+		go func(i int, ids []string) {
+			defer wg.Done()
+			users, _, err := client.User.GetMultipleByID(ctx, ids...)
+			require.NoError(t, err)
+			results[i] = users
+		}(i, ids)
+	}
+	wg.Wait()
+
+	require.Equal(t, 1, counter)
+	require.Equal(t, map[string]*UserSummary{"t2_1": expectedUsers["t2_1"]}, results[0])
+	require.Equal(t, map[string]*UserSummary{"t2_2": expectedUsers["t2_2"], "t2_3": expectedUsers["t2_3"]}, results[1])
+	require.Equal(t, map[string]*UserSummary{"t2_1": expectedUsers["t2_1"], "t2_3": expectedUsers["t2_3"]}, results[2])
+}
+
+func TestListingsService_Get_Batched(t *testing.T) {
+	client, mux := setup(t)
+
+	err := WithBatchWindow(time.Millisecond * 50)(client)
+	require.NoError(t, err)
+
+	blob, err := readFileContents("../testdata/listings/posts-comments-subreddits.json")
+	require.NoError(t, err)
+
+	var counter int
+	mux.HandleFunc("/api/info", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		counter++
+		fmt.Fprint(w, blob)
+	})
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+
+	go func() {
+		defer wg.Done()
+		posts, comments, subreddits, _, err := client.Listings.Get(ctx, "t3_i2gvg4")
+		require.NoError(t, err)
+		require.Equal(t, expectedListingPosts, posts)
+		require.Empty(t, comments)
+		require.Empty(t, subreddits)
+	}()
+
+	go func() {
+		defer wg.Done()
+		posts, comments, subreddits, _, err := client.Listings.Get(ctx, "t1_g05v931", "t5_2qh23")
+		require.NoError(t, err)
+		require.Empty(t, posts)
+		require.Equal(t, expectedListingComments, comments)
+		require.Equal(t, expectedListingSubreddits, subreddits)
+	}()
+
+	wg.Wait()
+	require.Equal(t, 1, counter)
+}
+
+func TestBatcher_Lookup_MaxSize(t *testing.T) {
+	var mu sync.Mutex
+	var sizes []int
+
+	b := newBatcher(time.Hour, func(_ context.Context, ids []string) (map[string]interface{}, *Response, error) {
+		mu.Lock()
+		sizes = append(sizes, len(ids))
+		mu.Unlock()
+
+		values := make(map[string]interface{}, len(ids))
+		for _, id := range ids {
+			values[id] = id
+		}
+		return values, nil, nil
+	})
+
+	ids := make([]string, maxBatchSize*2)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("t3_%d", i)
+	}
+
+	values, _, err := b.Lookup(ctx, ids)
+	require.NoError(t, err)
+	require.Len(t, values, maxBatchSize*2)
+	require.Equal(t, []int{maxBatchSize, maxBatchSize}, sizes)
+}
+
+func TestBatcher_Lookup_ContextCanceled(t *testing.T) {
+	b := newBatcher(time.Hour, func(_ context.Context, ids []string) (map[string]interface{}, *Response, error) {
+		return nil, nil, nil
+	})
+
+	canceledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+
+	_, _, err := b.Lookup(canceledCtx, []string{"t3_test"})
+	require.Equal(t, context.Canceled, err)
+}
@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"time"
 )
 
 // Opt is used to further configure a client upon initialization.
@@ -55,6 +56,93 @@ func WithTokenURL(u string) Opt {
 	}
 }
 
+// WithConnectionPool configures the connection pool of the client's HTTP transport.
+// If a custom HTTP client is used (via WithHTTPClient), it must be provided before this option,
+// and its transport must either be nil or an *http.Transport.
+func WithConnectionPool(pool ConnectionPool) Opt {
+	return func(c *Client) error {
+		if err := pool.validate(); err != nil {
+			return err
+		}
+
+		var transport *http.Transport
+		switch t := c.client.Transport.(type) {
+		case nil:
+			transport = newDefaultTransport()
+		case *http.Transport:
+			transport = t.Clone()
+		default:
+			return errors.New("connection pool can only be configured for an *http.Transport")
+		}
+
+		pool.apply(transport)
+		c.client.Transport = transport
+		return nil
+	}
+}
+
+// WithRetry enables retrying requests that fail because of a network error or a 5xx response from Reddit.
+// A request is retried at most maxRetries times, waiting for the backoff duration before the first retry,
+// and doubling it before each subsequent one.
+// Only requests that are safe to repeat are retried, such as ones fetching listings. Requests that create
+// or modify content (submitting a comment, voting, etc.) are never retried unless overridden via ContextWithRetry.
+func WithRetry(maxRetries int, backoff time.Duration) Opt {
+	return func(c *Client) error {
+		if maxRetries < 0 {
+			return errors.New("maxRetries: cannot be negative")
+		}
+		if backoff < 0 {
+			return errors.New("backoff: cannot be negative")
+		}
+		c.retry = retryConfig{MaxRetries: maxRetries, Backoff: backoff}
+		return nil
+	}
+}
+
+// WithRateBudgets sets budgets restricting how much of the rate limit certain classes of requests may use.
+// A request belongs to the first budget that matches it.
+func WithRateBudgets(budgets ...RateBudget) Opt {
+	return func(c *Client) error {
+		var reserved float64
+		c.rateBudgets = make([]*rateBudget, 0, len(budgets))
+
+		for _, budget := range budgets {
+			if budget.Match == nil {
+				return errors.New("(RateBudget).Match: cannot be nil")
+			}
+			if budget.PerMinute < 0 {
+				return errors.New("(RateBudget).PerMinute: cannot be negative")
+			}
+			if budget.Reserve < 0 || budget.Reserve > 1 {
+				return errors.New("(RateBudget).Reserve: must be between 0 and 1 (inclusive)")
+			}
+
+			reserved += budget.Reserve
+			c.rateBudgets = append(c.rateBudgets, &rateBudget{RateBudget: budget})
+		}
+
+		if reserved > 1 {
+			return errors.New("total reserve of the rate budgets cannot exceed 1")
+		}
+
+		return nil
+	}
+}
+
+// WithBatchWindow enables combining lookups of things by their IDs (via UserService.GetMultipleByID and
+// ListingsService.Get) that are made within the window, possibly from different goroutines, into as few
+// requests as possible. Each lookup waits for up to the window's duration before its request is made.
+func WithBatchWindow(window time.Duration) Opt {
+	return func(c *Client) error {
+		if window <= 0 {
+			return errors.New("window: must be positive")
+		}
+		c.User.batcher = newBatcher(window, c.User.getMultipleByIDBatch)
+		c.Listings.batcher = newBatcher(window, c.Listings.getBatch)
+		return nil
+	}
+}
+
 // FromEnv configures the client with values from environment variables.
 // Supported environment variables:
 // GO_REDDIT_CLIENT_ID to set the client's id.
@@ -6,6 +6,7 @@ import (
 	"net/url"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -72,3 +73,81 @@ func TestFromEnv(t *testing.T) {
 	require.Equal(t, "username1", c.Username)
 	require.Equal(t, "password1", c.Password)
 }
+
+func TestWithRetry(t *testing.T) {
+	_, err := NewClient(Credentials{}, WithRetry(-1, time.Second))
+	require.EqualError(t, err, "maxRetries: cannot be negative")
+
+	_, err = NewClient(Credentials{}, WithRetry(3, -time.Second))
+	require.EqualError(t, err, "backoff: cannot be negative")
+
+	c, err := NewClient(Credentials{}, WithRetry(3, time.Second))
+	require.NoError(t, err)
+	require.Equal(t, retryConfig{MaxRetries: 3, Backoff: time.Second}, c.retry)
+}
+
+func TestWithRateBudgets(t *testing.T) {
+	match := MatchEndpoints("search")
+
+	_, err := NewClient(Credentials{}, WithRateBudgets(RateBudget{}))
+	require.EqualError(t, err, "(RateBudget).Match: cannot be nil")
+
+	_, err = NewClient(Credentials{}, WithRateBudgets(RateBudget{Match: match, PerMinute: -1}))
+	require.EqualError(t, err, "(RateBudget).PerMinute: cannot be negative")
+
+	_, err = NewClient(Credentials{}, WithRateBudgets(RateBudget{Match: match, Reserve: 1.5}))
+	require.EqualError(t, err, "(RateBudget).Reserve: must be between 0 and 1 (inclusive)")
+
+	_, err = NewClient(Credentials{}, WithRateBudgets(RateBudget{Match: match, Reserve: 0.6}, RateBudget{Match: match, Reserve: 0.6}))
+	require.EqualError(t, err, "total reserve of the rate budgets cannot exceed 1")
+
+	c, err := NewClient(Credentials{}, WithRateBudgets(RateBudget{Match: match, PerMinute: 10, Reserve: 0.2}))
+	require.NoError(t, err)
+	require.Len(t, c.rateBudgets, 1)
+}
+
+func TestWithConnectionPool(t *testing.T) {
+	_, err := NewClient(Credentials{}, WithConnectionPool(ConnectionPool{MaxConnsPerHost: -1}))
+	require.EqualError(t, err, "ConnectionPool: number of connections cannot be negative")
+
+	_, err = NewClient(Credentials{}, WithConnectionPool(ConnectionPool{KeepAlive: -time.Second}))
+	require.EqualError(t, err, "ConnectionPool: durations cannot be negative")
+
+	_, err = NewClient(Credentials{}, WithHTTPClient(&http.Client{Transport: &userAgentTransport{}}), WithConnectionPool(ConnectionPool{}))
+	require.EqualError(t, err, "connection pool can only be configured for an *http.Transport")
+
+	c := newClient()
+	transport, ok := c.client.Transport.(*http.Transport)
+	require.True(t, ok)
+	require.Equal(t, defaultMaxIdleConnsPerHost, transport.MaxIdleConnsPerHost)
+
+	err = WithConnectionPool(ConnectionPool{MaxIdleConnsPerHost: 50, MaxConnsPerHost: 60, IdleConnTimeout: time.Minute})(c)
+	require.NoError(t, err)
+
+	transport, ok = c.client.Transport.(*http.Transport)
+	require.True(t, ok)
+	require.Equal(t, defaultMaxIdleConns, transport.MaxIdleConns)
+	require.Equal(t, 50, transport.MaxIdleConnsPerHost)
+	require.Equal(t, 60, transport.MaxConnsPerHost)
+	require.Equal(t, time.Minute, transport.IdleConnTimeout)
+
+	c = newClient()
+	c.client = &http.Client{}
+	err = WithConnectionPool(ConnectionPool{MaxConnsPerHost: 5})(c)
+	require.NoError(t, err)
+
+	transport, ok = c.client.Transport.(*http.Transport)
+	require.True(t, ok)
+	require.Equal(t, 5, transport.MaxConnsPerHost)
+	require.Equal(t, defaultMaxIdleConnsPerHost, transport.MaxIdleConnsPerHost)
+}
+
+func TestWithBatchWindow(t *testing.T) {
+	_, err := NewClient(Credentials{}, WithBatchWindow(0))
+	require.EqualError(t, err, "window: must be positive")
+
+	c, err := NewClient(Credentials{}, WithBatchWindow(time.Millisecond))
+	require.NoError(t, err)
+	require.NotNil(t, c.User.batcher)
+	require.NotNil(t, c.Listings.batcher)
+}
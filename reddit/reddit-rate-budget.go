@@ -0,0 +1,118 @@
+package reddit
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RateBudget restricts how much of the client's rate limit a class of requests may consume.
+// This is useful when a single client is shared by different workloads, e.g. to prevent a
+// heavy crawler from starving time-critical moderator actions.
+type RateBudget struct {
+	// Match reports whether a request belongs to this class of requests.
+	// MatchEndpoints can be used to build one.
+	Match func(*http.Request) bool
+
+	// Maximum number of requests of this class that can be made per minute.
+	// If 0, the number of requests is not capped.
+	PerMinute int
+
+	// Fraction (between 0 and 1) of the rate limit held back for requests of this class.
+	// Requests outside of this class will not be made if they would dip into the reserve.
+	Reserve float64
+}
+
+type rateBudget struct {
+	RateBudget
+
+	mu   sync.Mutex
+	sent []time.Time
+}
+
+// MatchEndpoints returns a function that matches requests made to any of the endpoints.
+// Endpoints are specified without a preceding slash, and without the subreddit prefix,
+// e.g. "search" matches requests to both "search" and "r/golang/search", while "about"
+// matches all of "r/{subreddit}/about/..." endpoints.
+func MatchEndpoints(endpoints ...string) func(*http.Request) bool {
+	return func(req *http.Request) bool {
+		path := strings.Trim(req.URL.Path, "/")
+		path = strings.TrimSuffix(path, ".json")
+
+		if strings.HasPrefix(path, "r/") {
+			if i := strings.Index(path[2:], "/"); i != -1 {
+				path = path[2+i+1:]
+			}
+		}
+
+		for _, endpoint := range endpoints {
+			endpoint = strings.Trim(endpoint, "/")
+			if path == endpoint || strings.HasPrefix(path, endpoint+"/") {
+				return true
+			}
+		}
+
+		return false
+	}
+}
+
+// budgetFor returns the first budget that matches the request, if any.
+func (c *Client) budgetFor(req *http.Request) *rateBudget {
+	for _, b := range c.rateBudgets {
+		if b.Match(req) {
+			return b
+		}
+	}
+	return nil
+}
+
+// checkRateBudgets makes sure the request doesn't exceed the budget of its class,
+// and that it doesn't use up the rate limit reserved for other classes.
+func (c *Client) checkRateBudgets(req *http.Request, rate Rate) *RateLimitError {
+	if len(c.rateBudgets) == 0 {
+		return nil
+	}
+
+	budget := c.budgetFor(req)
+
+	var reserved float64
+	for _, b := range c.rateBudgets {
+		if b != budget {
+			reserved += b.Reserve
+		}
+	}
+
+	// once the rate limit window resets, the last known rate no longer applies
+	windowActive := time.Now().Before(rate.Reset)
+
+	total := rate.Used + rate.Remaining
+	if windowActive && reserved > 0 && total > 0 && float64(rate.Remaining) <= reserved*float64(total) {
+		return newRateLimitError(req, rate, "The remaining API rate limit is reserved for other requests, not making remote request.")
+	}
+
+	if budget == nil || budget.PerMinute == 0 {
+		return nil
+	}
+
+	budget.mu.Lock()
+	defer budget.mu.Unlock()
+
+	now := time.Now()
+
+	// drop the requests that are now outside of the 1 minute window
+	var i int
+	for i < len(budget.sent) && now.Sub(budget.sent[i]) >= time.Minute {
+		i++
+	}
+	budget.sent = budget.sent[i:]
+
+	if len(budget.sent) >= budget.PerMinute {
+		rate.Reset = budget.sent[0].Add(time.Minute)
+		return newRateLimitError(req, rate, fmt.Sprintf("Rate budget of %d requests per minute exceeded until %s, not making remote request.", budget.PerMinute, rate.Reset))
+	}
+
+	budget.sent = append(budget.sent, now)
+	return nil
+}
@@ -0,0 +1,141 @@
+package reddit
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchEndpoints(t *testing.T) {
+	client, _ := setup(t)
+	match := MatchEndpoints("search", "api/remove", "about")
+
+	for path, expected := range map[string]bool{
+		"search":                true,
+		"r/golang/search":       true,
+		"api/remove":            true,
+		"r/golang/about/queue":  true,
+		"r/golang/hot":          false,
+		"api/removal_reasons":   false,
+		"r/golang/searchthings": false,
+	} {
+		req, err := client.NewRequest(http.MethodGet, path, nil)
+		require.NoError(t, err)
+		require.Equalf(t, expected, match(req), "path %q", path)
+	}
+}
+
+func TestClient_Do_RateBudget_PerMinute(t *testing.T) {
+	client, mux := setup(t)
+
+	err := WithRateBudgets(RateBudget{Match: MatchEndpoints("search"), PerMinute: 2})(client)
+	require.NoError(t, err)
+
+	var counter int
+	mux.HandleFunc("/r/golang/search", func(w http.ResponseWriter, r *http.Request) {
+		counter++
+	})
+	mux.HandleFunc("/r/golang/hot", func(w http.ResponseWriter, r *http.Request) {
+		counter++
+	})
+
+	req, err := client.NewRequest(http.MethodGet, "r/golang/search", nil)
+	require.NoError(t, err)
+
+	_, err = client.Do(ctx, req, nil)
+	require.NoError(t, err)
+	_, err = client.Do(ctx, req, nil)
+	require.NoError(t, err)
+
+	resp, err := client.Do(ctx, req, nil)
+	require.IsType(t, &RateLimitError{}, err)
+	require.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	require.Equal(t, 2, counter)
+
+	req, err = client.NewRequest(http.MethodGet, "r/golang/hot", nil)
+	require.NoError(t, err)
+
+	_, err = client.Do(ctx, req, nil)
+	require.NoError(t, err)
+	require.Equal(t, 3, counter)
+}
+
+func TestClient_Do_RateBudget_Reserve(t *testing.T) {
+	client, mux := setup(t)
+
+	err := WithRateBudgets(RateBudget{Match: MatchEndpoints("api/approve", "api/remove"), Reserve: 0.2})(client)
+	require.NoError(t, err)
+
+	mux.HandleFunc("/r/golang/hot", func(w http.ResponseWriter, r *http.Request) {})
+	mux.HandleFunc("/api/approve", func(w http.ResponseWriter, r *http.Request) {})
+
+	reset := time.Now().Add(time.Minute)
+	client.rate = Rate{Remaining: 100, Used: 500, Reset: reset}
+
+	crawl, err := client.NewRequest(http.MethodGet, "r/golang/hot", nil)
+	require.NoError(t, err)
+
+	_, err = client.Do(ctx, crawl, nil)
+	require.IsType(t, &RateLimitError{}, err)
+
+	approve, err := client.NewRequest(http.MethodPost, "api/approve", nil)
+	require.NoError(t, err)
+
+	client.rate = Rate{Remaining: 100, Used: 500, Reset: reset}
+	_, err = client.Do(ctx, approve, nil)
+	require.NoError(t, err)
+
+	client.rate = Rate{Remaining: 300, Used: 300, Reset: reset}
+	_, err = client.Do(ctx, crawl, nil)
+	require.NoError(t, err)
+}
+
+func TestClient_Do_RateBudget_Reserve_Expired(t *testing.T) {
+	client, mux := setup(t)
+
+	err := WithRateBudgets(RateBudget{Match: MatchEndpoints("api/approve"), Reserve: 0.2})(client)
+	require.NoError(t, err)
+
+	var counter int
+	mux.HandleFunc("/r/golang/hot", func(w http.ResponseWriter, r *http.Request) {
+		counter++
+	})
+
+	crawl, err := client.NewRequest(http.MethodGet, "r/golang/hot", nil)
+	require.NoError(t, err)
+
+	// the window in which the reserve was reached is over
+	client.rate = Rate{Remaining: 100, Used: 500, Reset: time.Now().Add(-time.Second)}
+	_, err = client.Do(ctx, crawl, nil)
+	require.NoError(t, err)
+
+	// no reset time is known
+	client.rate = Rate{Remaining: 100, Used: 500}
+	_, err = client.Do(ctx, crawl, nil)
+	require.NoError(t, err)
+	require.Equal(t, 2, counter)
+}
+
+func TestClient_Do_RateBudget_Retry(t *testing.T) {
+	client, mux := setup(t)
+	client.retry = retryConfig{MaxRetries: 3}
+
+	err := WithRateBudgets(RateBudget{Match: MatchEndpoints("search"), PerMinute: 2})(client)
+	require.NoError(t, err)
+
+	var counter int
+	mux.HandleFunc("/r/golang/search", func(w http.ResponseWriter, r *http.Request) {
+		counter++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	req, err := client.NewRequest(http.MethodGet, "r/golang/search", nil)
+	require.NoError(t, err)
+
+	resp, err := client.Do(ctx, req, nil)
+	require.IsType(t, &RateLimitError{}, err)
+	require.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	require.Equal(t, 2, counter)
+}
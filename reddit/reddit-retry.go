@@ -0,0 +1,118 @@
+package reddit
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+)
+
+type retryConfig struct {
+	MaxRetries int
+	Backoff    time.Duration
+}
+
+type retryContextKey struct{}
+
+// ContextWithRetry returns a copy of ctx that overrides whether requests made with it may be retried.
+// By default, only requests that are safe to repeat (e.g. fetching listings) are retried. Passing true
+// allows any request to be retried, while passing false prevents the request from being retried at all.
+func ContextWithRetry(ctx context.Context, retry bool) context.Context {
+	return context.WithValue(ctx, retryContextKey{}, retry)
+}
+
+// Endpoints that only read data, despite requiring a POST request.
+var idempotentPostPaths = map[string]bool{
+	"api/morechildren": true,
+}
+
+// isRetryable determines whether the request can be safely sent more than once.
+// Requests that create or modify content, such as submitting a comment or voting on a post,
+// are not retried, so that a failure which happens after Reddit processed the request does
+// not result in a duplicate action.
+func isRetryable(ctx context.Context, req *http.Request) bool {
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		// the body cannot be rewound, so it cannot be sent again
+		return false
+	}
+
+	if retry, ok := ctx.Value(retryContextKey{}).(bool); ok {
+		return retry
+	}
+
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+
+	path := strings.TrimPrefix(req.URL.Path, "/")
+	path = strings.TrimSuffix(path, ".json")
+	return idempotentPostPaths[path]
+}
+
+// shouldRetry determines whether the outcome of a request is worth retrying.
+func shouldRetry(ctx context.Context, resp *http.Response, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	if err != nil {
+		return true
+	}
+
+	switch resp.StatusCode {
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+
+	return false
+}
+
+// doWithRetry sends the request, retrying it with an exponential backoff if it is safe to do so.
+// The rate limit and rate budgets are checked before every attempt; if one of them prevents the
+// request from being sent, a *RateLimitError is returned.
+func (c *Client) doWithRetry(ctx context.Context, req *http.Request) (*http.Response, error) {
+	var maxRetries int
+	if isRetryable(ctx, req) {
+		maxRetries = c.retry.MaxRetries
+	}
+
+	for attempt := 0; ; attempt++ {
+		if err := c.checkRateLimitBeforeDo(req); err != nil {
+			return nil, err
+		}
+
+		resp, err := DoRequestWithClient(ctx, c.client, req)
+		if attempt >= maxRetries || !shouldRetry(ctx, resp, err) {
+			return resp, err
+		}
+
+		if resp != nil {
+			// the failed attempt still counts towards the rate limit
+			c.rateMu.Lock()
+			c.rate = parseRate(resp)
+			c.rateMu.Unlock()
+
+			io.Copy(ioutil.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+
+		timer := time.NewTimer(c.retry.Backoff << uint(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
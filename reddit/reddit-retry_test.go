@@ -0,0 +1,134 @@
+package reddit
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_Do_Retry(t *testing.T) {
+	client, mux := setup(t)
+	client.retry = retryConfig{MaxRetries: 2}
+
+	var counter int
+	mux.HandleFunc("/api/v1/test", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		defer func() { counter++ }()
+
+		if counter < 2 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, `{}`)
+	})
+
+	req, err := client.NewRequest(http.MethodGet, "api/v1/test", nil)
+	require.NoError(t, err)
+
+	resp, err := client.Do(ctx, req, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, 3, counter)
+}
+
+func TestClient_Do_Retry_Exhausted(t *testing.T) {
+	client, mux := setup(t)
+	client.retry = retryConfig{MaxRetries: 2}
+
+	var counter int
+	mux.HandleFunc("/api/v1/test", func(w http.ResponseWriter, r *http.Request) {
+		counter++
+		w.WriteHeader(http.StatusBadGateway)
+	})
+
+	req, err := client.NewRequest(http.MethodGet, "api/v1/test", nil)
+	require.NoError(t, err)
+
+	resp, err := client.Do(ctx, req, nil)
+	require.IsType(t, &ErrorResponse{}, err)
+	require.Equal(t, http.StatusBadGateway, resp.StatusCode)
+	require.Equal(t, 3, counter)
+}
+
+func TestClient_Do_Retry_Unsafe(t *testing.T) {
+	client, mux := setup(t)
+	client.retry = retryConfig{MaxRetries: 2}
+
+	var counter int
+	mux.HandleFunc("/api/comment", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		counter++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	form := url.Values{}
+	form.Set("text", "hello")
+
+	req, err := client.NewRequest(http.MethodPost, "api/comment", form)
+	require.NoError(t, err)
+
+	_, err = client.Do(ctx, req, nil)
+	require.IsType(t, &ErrorResponse{}, err)
+	require.Equal(t, 1, counter)
+}
+
+func TestClient_Do_Retry_Override(t *testing.T) {
+	client, mux := setup(t)
+	client.retry = retryConfig{MaxRetries: 2}
+
+	var counter int
+	mux.HandleFunc("/api/comment", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		defer func() { counter++ }()
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, "hello", r.PostForm.Get("text"))
+
+		if counter == 0 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	})
+
+	form := url.Values{}
+	form.Set("text", "hello")
+
+	req, err := client.NewRequest(http.MethodPost, "api/comment", form)
+	require.NoError(t, err)
+
+	_, err = client.Do(ContextWithRetry(ctx, true), req, nil)
+	require.NoError(t, err)
+	require.Equal(t, 2, counter)
+
+	mux.HandleFunc("/api/v1/test", func(w http.ResponseWriter, r *http.Request) {
+		counter++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	req, err = client.NewRequest(http.MethodGet, "api/v1/test", nil)
+	require.NoError(t, err)
+
+	_, err = client.Do(ContextWithRetry(ctx, false), req, nil)
+	require.IsType(t, &ErrorResponse{}, err)
+	require.Equal(t, 3, counter)
+}
+
+func TestIsRetryable(t *testing.T) {
+	client, _ := setup(t)
+
+	req, err := client.NewRequest(http.MethodGet, "r/golang/hot", nil)
+	require.NoError(t, err)
+	require.True(t, isRetryable(ctx, req))
+
+	req, err = client.NewRequest(http.MethodPost, "api/morechildren", url.Values{})
+	require.NoError(t, err)
+	require.True(t, isRetryable(ctx, req))
+
+	req, err = client.NewRequest(http.MethodPost, "api/vote", url.Values{})
+	require.NoError(t, err)
+	require.False(t, isRetryable(ctx, req))
+	require.True(t, isRetryable(ContextWithRetry(ctx, true), req))
+}
@@ -0,0 +1,77 @@
+package reddit
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"time"
+)
+
+// Since all requests are made to the same host, the standard library's default of 2 idle
+// connections per host results in connections constantly being closed and reopened
+// when making concurrent requests (e.g. when streaming).
+const (
+	defaultMaxIdleConns        = 100
+	defaultMaxIdleConnsPerHost = 10
+	defaultIdleConnTimeout     = time.Second * 90
+	defaultDialTimeout         = time.Second * 30
+	defaultKeepAlive           = time.Second * 30
+)
+
+// ConnectionPool configures the pool of connections the client keeps open to Reddit.
+// Fields left as 0 keep their current value.
+type ConnectionPool struct {
+	// Maximum number of idle connections across all hosts.
+	MaxIdleConns int
+	// Maximum number of idle connections to keep per host.
+	MaxIdleConnsPerHost int
+	// Maximum number of connections (active and idle) per host.
+	MaxConnsPerHost int
+	// How long an idle connection remains open before being closed.
+	IdleConnTimeout time.Duration
+	// Interval between keep-alive probes for active connections.
+	KeepAlive time.Duration
+}
+
+func (p ConnectionPool) validate() error {
+	if p.MaxIdleConns < 0 || p.MaxIdleConnsPerHost < 0 || p.MaxConnsPerHost < 0 {
+		return errors.New("ConnectionPool: number of connections cannot be negative")
+	}
+	if p.IdleConnTimeout < 0 || p.KeepAlive < 0 {
+		return errors.New("ConnectionPool: durations cannot be negative")
+	}
+	return nil
+}
+
+func (p ConnectionPool) apply(t *http.Transport) {
+	if p.MaxIdleConns > 0 {
+		t.MaxIdleConns = p.MaxIdleConns
+	}
+	if p.MaxIdleConnsPerHost > 0 {
+		t.MaxIdleConnsPerHost = p.MaxIdleConnsPerHost
+	}
+	if p.MaxConnsPerHost > 0 {
+		t.MaxConnsPerHost = p.MaxConnsPerHost
+	}
+	if p.IdleConnTimeout > 0 {
+		t.IdleConnTimeout = p.IdleConnTimeout
+	}
+	if p.KeepAlive > 0 {
+		t.DialContext = (&net.Dialer{
+			Timeout:   defaultDialTimeout,
+			KeepAlive: p.KeepAlive,
+		}).DialContext
+	}
+}
+
+// newDefaultTransport returns the transport used by the client's HTTP client when none is provided.
+func newDefaultTransport() *http.Transport {
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	ConnectionPool{
+		MaxIdleConns:        defaultMaxIdleConns,
+		MaxIdleConnsPerHost: defaultMaxIdleConnsPerHost,
+		IdleConnTimeout:     defaultIdleConnTimeout,
+		KeepAlive:           defaultKeepAlive,
+	}.apply(t)
+	return t
+}
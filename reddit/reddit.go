@@ -4,18 +4,23 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"mime/multipart"
 	"net/http"
 	"net/url"
+	"os"
 	"reflect"
 	"strconv"
 	"strings"
 	"sync"
 	"time"
+	"unicode/utf8"
 
 	"github.com/google/go-querystring/query"
+	"golang.org/x/net/context/ctxhttp"
 	"golang.org/x/oauth2"
 )
 
@@ -70,6 +75,9 @@ type Client struct {
 	rateMu sync.Mutex
 	rate   Rate
 
+	retry       retryConfig
+	rateBudgets []*rateBudget
+
 	ID       string
 	Secret   string
 	Username string
@@ -83,6 +91,7 @@ type Client struct {
 	Comment    *CommentService
 	Emoji      *EmojiService
 	Flair      *FlairService
+	Frontpage  *FrontpageService
 	Gold       *GoldService
 	Listings   *ListingsService
 	LiveThread *LiveThreadService
@@ -110,12 +119,13 @@ func newClient() *Client {
 	baseURL, _ := url.Parse(defaultBaseURL)
 	tokenURL, _ := url.Parse(defaultTokenURL)
 
-	client := &Client{client: &http.Client{}, BaseURL: baseURL, TokenURL: tokenURL}
+	client := &Client{client: &http.Client{Transport: newDefaultTransport()}, BaseURL: baseURL, TokenURL: tokenURL}
 
 	client.Account = &AccountService{client: client}
 	client.Collection = &CollectionService{client: client}
 	client.Emoji = &EmojiService{client: client}
 	client.Flair = &FlairService{client: client}
+	client.Frontpage = &FrontpageService{client: client}
 	client.Gold = &GoldService{client: client}
 	client.Listings = &ListingsService{client: client}
 	client.LiveThread = &LiveThreadService{client: client}
@@ -338,14 +348,13 @@ func parseRate(r *http.Response) Rate {
 // pointed to by v, or returned as an error if an API error has occurred. If v implements the io.Writer interface,
 // the raw response will be written to v, without attempting to decode it.
 func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
-	if err := c.checkRateLimitBeforeDo(req); err != nil {
+	resp, err := c.doWithRetry(ctx, req)
+	if rateLimitErr, ok := err.(*RateLimitError); ok {
 		return &Response{
-			Response: err.Response,
-			Rate:     err.Rate,
-		}, err
+			Response: rateLimitErr.Response,
+			Rate:     rateLimitErr.Rate,
+		}, rateLimitErr
 	}
-
-	resp, err := DoRequestWithClient(ctx, c.client, req)
 	if err != nil {
 		return nil, err
 	}
@@ -393,22 +402,27 @@ func (c *Client) checkRateLimitBeforeDo(req *http.Request) *RateLimitError {
 	c.rateMu.Unlock()
 
 	if !rate.Reset.IsZero() && rate.Remaining == 0 && time.Now().Before(rate.Reset) {
-		// Create a fake 429 response.
-		resp := &http.Response{
-			Status:     http.StatusText(http.StatusTooManyRequests),
-			StatusCode: http.StatusTooManyRequests,
-			Request:    req,
-			Header:     make(http.Header),
-			Body:       ioutil.NopCloser(strings.NewReader("")),
-		}
-		return &RateLimitError{
-			Rate:     rate,
-			Response: resp,
-			Message:  fmt.Sprintf("API rate limit still exceeded until %s, not making remote request.", rate.Reset),
-		}
+		return newRateLimitError(req, rate, fmt.Sprintf("API rate limit still exceeded until %s, not making remote request.", rate.Reset))
 	}
 
-	return nil
+	return c.checkRateBudgets(req, rate)
+}
+
+// newRateLimitError creates a rate limit error with a fake 429 response,
+// for when a request is prevented from being made.
+func newRateLimitError(req *http.Request, rate Rate, message string) *RateLimitError {
+	resp := &http.Response{
+		Status:     http.StatusText(http.StatusTooManyRequests),
+		StatusCode: http.StatusTooManyRequests,
+		Request:    req,
+		Header:     make(http.Header),
+		Body:       ioutil.NopCloser(strings.NewReader("")),
+	}
+	return &RateLimitError{
+		Rate:     rate,
+		Response: resp,
+		Message:  message,
+	}
 }
 
 // id returns the client's Reddit ID.
@@ -476,6 +490,24 @@ func CheckResponse(r *http.Response) error {
 		}
 	}
 
+	if errorResponse.Reason == "quarantined" {
+		quarantinedError := &QuarantinedError{ErrorResponse: errorResponse}
+		json.Unmarshal(data, quarantinedError)
+		return quarantinedError
+	}
+
+	if errorResponse.Reason == "EDIT_CONFLICT" {
+		conflictError := &WikiEditConflictError{ErrorResponse: errorResponse}
+		json.Unmarshal(data, conflictError)
+		return conflictError
+	}
+
+	if errorResponse.Reason == "SPECIAL_ERRORS" {
+		specialPageError := &WikiSpecialPageError{ErrorResponse: errorResponse}
+		json.Unmarshal(data, specialPageError)
+		return specialPageError
+	}
+
 	return errorResponse
 }
 
@@ -520,6 +552,79 @@ func (c *Client) getListing(ctx context.Context, path string, opts interface{})
 	return l, resp, nil
 }
 
+// uploadLease is a lease obtained from Reddit to upload a file to Amazon S3.
+type uploadLease struct {
+	Action string `json:"action"`
+	Fields []struct {
+		Name  string `json:"name"`
+		Value string `json:"value"`
+	} `json:"fields"`
+}
+
+// uploadWithLease uploads the file to the Amazon S3 URL of the lease, and returns the URL of the uploaded file.
+func (c *Client) uploadWithLease(ctx context.Context, lease uploadLease, filePath string) (string, *Response, error) {
+	// the action is a protocol-relative URL, e.g. //reddit-uploaded-media.s3-accelerate.amazonaws.com
+	uploadURL := fmt.Sprintf("%s:%s", c.BaseURL.Scheme, lease.Action)
+
+	fields := make(map[string]string)
+	for _, field := range lease.Fields {
+		fields[field.Name] = field.Value
+	}
+
+	resp, err := uploadToS3(ctx, uploadURL, fields, filePath)
+	if err != nil {
+		return "", resp, err
+	}
+
+	return fmt.Sprintf("%s/%s", uploadURL, fields["key"]), resp, nil
+}
+
+// uploadToS3 uploads the file to the Amazon S3 URL obtained via an upload lease from Reddit.
+func uploadToS3(ctx context.Context, uploadURL string, fields map[string]string, filePath string) (*Response, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+
+	// AWS ignores all fields in the request that come after the file field, so we need to set these before
+	// https://stackoverflow.com/questions/15234496/upload-directly-to-amazon-s3-using-ajax-returning-error-bucket-post-must-contai/15235866#15235866
+	for k, v := range fields {
+		writer.WriteField(k, v)
+	}
+
+	part, err := writer.CreateFormFile("file", file.Name())
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = io.Copy(part, file)
+	if err != nil {
+		return nil, err
+	}
+
+	err = writer.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	httpResponse, err := ctxhttp.Post(ctx, nil, uploadURL, writer.FormDataContentType(), body)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResponse.Body.Close()
+
+	err = CheckResponse(httpResponse)
+	if err != nil {
+		return newResponse(httpResponse), err
+	}
+
+	return newResponse(httpResponse), nil
+}
+
 // ListOptions specifies the optional parameters to various API calls that return a listing.
 type ListOptions struct {
 	// Maximum number of items to be returned.
@@ -544,18 +649,100 @@ type ListSubredditOptions struct {
 	Sort string `url:"sort,omitempty"`
 }
 
+// ListSubredditAutocompleteOptions defines possible options used when autocompleting subreddit names.
+type ListSubredditAutocompleteOptions struct {
+	// Maximum number of subreddits to return. The default is 5 and max is 10.
+	Limit int `url:"limit,omitempty"`
+	// Include NSFW subreddits.
+	IncludeNSFW bool `url:"include_over_18,omitempty"`
+	// Include user profiles, e.g. u_spez.
+	IncludeProfiles bool `url:"include_profiles,omitempty"`
+}
+
+// TimeFilter is the period of time from which to get items, when sorting them by top or controversial.
+type TimeFilter string
+
+const (
+	TimeFilterHour  TimeFilter = "hour"
+	TimeFilterDay   TimeFilter = "day"
+	TimeFilterWeek  TimeFilter = "week"
+	TimeFilterMonth TimeFilter = "month"
+	TimeFilterYear  TimeFilter = "year"
+	TimeFilterAll   TimeFilter = "all"
+)
+
 // ListPostOptions defines possible options used when getting posts from a subreddit.
 type ListPostOptions struct {
 	ListOptions
-	// One of: hour, day, week, month, year, all.
-	Time string `url:"t,omitempty"`
+	// Only applies when sorting by top or controversial.
+	Time TimeFilter `url:"t,omitempty"`
+}
+
+// Region is a region of the world from which to get popular posts.
+type Region string
+
+const (
+	RegionGlobal        Region = "GLOBAL"
+	RegionArgentina     Region = "AR"
+	RegionAustralia     Region = "AU"
+	RegionCanada        Region = "CA"
+	RegionFrance        Region = "FR"
+	RegionGermany       Region = "DE"
+	RegionIndia         Region = "IN"
+	RegionIreland       Region = "IE"
+	RegionItaly         Region = "IT"
+	RegionJapan         Region = "JP"
+	RegionMexico        Region = "MX"
+	RegionNetherlands   Region = "NL"
+	RegionNewZealand    Region = "NZ"
+	RegionPoland        Region = "PL"
+	RegionPortugal      Region = "PT"
+	RegionSpain         Region = "ES"
+	RegionSweden        Region = "SE"
+	RegionUnitedKingdom Region = "GB"
+	RegionUnitedStates  Region = "US"
+)
+
+// ListPopularPostOptions defines possible options used when getting popular posts.
+type ListPopularPostOptions struct {
+	ListOptions
+	// Defaults to the region Reddit guesses you are in.
+	Region Region `url:"g,omitempty"`
 }
 
+// SearchSort is the order in which search results are sorted.
+type SearchSort string
+
+const (
+	SearchSortRelevance SearchSort = "relevance"
+	SearchSortHot       SearchSort = "hot"
+	SearchSortTop       SearchSort = "top"
+	SearchSortNew       SearchSort = "new"
+	// SearchSortComments sorts posts by their number of comments.
+	SearchSortComments SearchSort = "comments"
+)
+
 // ListPostSearchOptions defines possible options used when searching for posts within a subreddit.
 type ListPostSearchOptions struct {
 	ListPostOptions
-	// One of: relevance, hot, top, new, comments.
-	Sort string `url:"sort,omitempty"`
+	// If empty, results are sorted by relevance.
+	Sort SearchSort `url:"sort,omitempty"`
+	// Only return posts with this flair text, e.g. "Bug".
+	// It is added to the search query as flair:"Bug", so the query itself can be empty.
+	// It cannot contain double quotes.
+	Flair string `url:"-"`
+	// No longer than 5 characters.
+	Category string `url:"category,omitempty"`
+}
+
+func (o ListPostSearchOptions) validate() error {
+	if strings.Contains(o.Flair, `"`) {
+		return errors.New("(ListPostSearchOptions).Flair: cannot contain double quotes")
+	}
+	if utf8.RuneCountInString(o.Category) > 5 {
+		return errors.New("(ListPostSearchOptions).Category: cannot be longer than 5 characters")
+	}
+	return nil
 }
 
 // ListUserOverviewOptions defines possible options used when getting a user's post and/or comments.
@@ -563,8 +750,38 @@ type ListUserOverviewOptions struct {
 	ListOptions
 	// One of: hot, new, top, controversial.
 	Sort string `url:"sort,omitempty"`
-	// One of: hour, day, week, month, year, all.
-	Time string `url:"t,omitempty"`
+	// Only applies when sorting by top or controversial.
+	Time TimeFilter `url:"t,omitempty"`
+}
+
+// CommentSort is the order in which the comments of a post are sorted.
+type CommentSort string
+
+const (
+	// CommentSortConfidence sorts comments by "best", Reddit's default.
+	CommentSortConfidence CommentSort = "confidence"
+	CommentSortTop        CommentSort = "top"
+	CommentSortNew        CommentSort = "new"
+	// CommentSortControversial sorts comments by how evenly split their up and downvotes are.
+	CommentSortControversial CommentSort = "controversial"
+	CommentSortOld           CommentSort = "old"
+	CommentSortRandom        CommentSort = "random"
+	// CommentSortQA puts comments in which the post's author participates first.
+	CommentSortQA   CommentSort = "qa"
+	CommentSortLive CommentSort = "live"
+)
+
+// ListPostCommentsOptions defines possible options used when getting a post and its comments.
+type ListPostCommentsOptions struct {
+	// Maximum number of comments to return.
+	Limit int `url:"limit,omitempty"`
+	// Maximum depth of the comment tree to return.
+	Depth int `url:"depth,omitempty"`
+	// Number of parents of the focused comment to return.
+	// Only applies when getting a specific comment thread.
+	Context int `url:"context,omitempty"`
+	// If empty, the post's suggested sort is used, if it has one.
+	Sort CommentSort `url:"sort,omitempty"`
 }
 
 // ListDuplicatePostOptions defines possible options used when getting duplicates of a post, i.e.
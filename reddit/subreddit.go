@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"html"
 	"io"
 	"mime/multipart"
 	"net/http"
@@ -64,6 +65,14 @@ type SubredditRule struct {
 	Created         *Timestamp `json:"created_utc,omitempty"`
 }
 
+// ReportReasons are the reasons available when reporting a post or comment in a subreddit.
+type ReportReasons struct {
+	// To be used as (ReportOptions).RuleReason.
+	RuleReasons []string `json:"rule_reasons"`
+	// To be used as (ReportOptions).SiteReason.
+	SiteReasons []string `json:"site_reasons"`
+}
+
 // SubredditRuleCreateRequest represents a request to add a subreddit rule.
 type SubredditRuleCreateRequest struct {
 	// One of: comment, link (i.e. post) or all (i.e. both).
@@ -195,6 +204,10 @@ type SubredditSettings struct {
 
 	// An integer from 0 to 3.
 	CrowdControlChalLevel *int `url:"crowd_control_chat_level,omitempty" json:"crowd_control_chat_level,omitempty"`
+	// Collapse comments from users who aren't trusted members of the community.
+	CrowdControlMode *bool `url:"crowd_control_mode,omitempty" json:"crowd_control_mode,omitempty"`
+	// An integer from 0 (lenient) to 3 (strict), used when CrowdControlMode is enabled.
+	CrowdControlLevel *int `url:"crowd_control_level,omitempty" json:"crowd_control_level,omitempty"`
 
 	// Mark all posts in this subreddit as Original Content (OC) on the desktop redesign.
 	AllOriginalContent *bool `url:"all_original_content,omitempty" json:"all_original_content,omitempty"`
@@ -324,6 +337,11 @@ func (s *SubredditService) HotPosts(ctx context.Context, subreddit string, opts
 	return s.getPosts(ctx, "hot", subreddit, opts)
 }
 
+// PopularPosts returns the posts trending across Reddit (r/popular), in the region set in the options.
+func (s *SubredditService) PopularPosts(ctx context.Context, opts *ListPopularPostOptions) ([]*Post, *Response, error) {
+	return s.getPosts(ctx, "hot", "popular", opts)
+}
+
 // NewPosts returns the newest posts from the specified subreddit.
 // To search through multiple, separate the names with a plus (+), e.g. "golang+test".
 // If none are defined, it returns the ones from your subscribed subreddits.
@@ -376,6 +394,65 @@ func (s *SubredditService) Get(ctx context.Context, name string) (*Subreddit, *R
 	return sr, resp, nil
 }
 
+// ResolveFullIDs returns the full IDs (e.g. t5_2rc7j) of subreddits, keyed by the names provided.
+// Names of subreddits that cannot be found are absent from the map.
+// At most 100 names can be provided.
+func (s *SubredditService) ResolveFullIDs(ctx context.Context, names ...string) (map[string]string, *Response, error) {
+	if len(names) == 0 {
+		return nil, nil, errors.New("must provide at least 1 name")
+	}
+	if len(names) > maxBatchSize {
+		return nil, nil, fmt.Errorf("cannot provide more than %d names", maxBatchSize)
+	}
+
+	path := "api/info"
+	params := struct {
+		Names []string `url:"sr_name,comma"`
+	}{names}
+
+	l, resp, err := s.client.getListing(ctx, path, params)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	fullIDs := make(map[string]string)
+	for _, name := range names {
+		for _, subreddit := range l.Subreddits() {
+			if strings.EqualFold(subreddit.Name, name) {
+				fullIDs[name] = subreddit.FullID
+				break
+			}
+		}
+	}
+
+	return fullIDs, resp, nil
+}
+
+// ResolveNames returns the names of subreddits, keyed by the full IDs provided.
+// IDs of subreddits that cannot be found are absent from the map.
+func (s *SubredditService) ResolveNames(ctx context.Context, fullIDs ...string) (map[string]string, *Response, error) {
+	if len(fullIDs) == 0 {
+		return nil, nil, errors.New("must provide at least 1 id")
+	}
+	for _, id := range fullIDs {
+		if !strings.HasPrefix(id, kindSubreddit+"_") {
+			return nil, nil, fmt.Errorf("%q: must be the full ID of a subreddit", id)
+		}
+	}
+
+	_, _, subreddits, resp, err := s.client.Listings.Get(ctx, fullIDs...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	names := make(map[string]string, len(subreddits))
+	for _, subreddit := range subreddits {
+		names[subreddit.FullID] = subreddit.Name
+	}
+
+	return names, resp, nil
+}
+
 // Popular returns popular subreddits.
 func (s *SubredditService) Popular(ctx context.Context, opts *ListSubredditOptions) ([]*Subreddit, *Response, error) {
 	return s.getSubreddits(ctx, "subreddits/popular", opts)
@@ -388,10 +465,18 @@ func (s *SubredditService) New(ctx context.Context, opts *ListSubredditOptions)
 
 // Gold returns gold subreddits (i.e. only accessible to users with gold).
 // It seems like it returns an empty list if you don't have gold.
+//
+// Deprecated: Reddit gold was renamed to Reddit Premium, use Premium instead.
 func (s *SubredditService) Gold(ctx context.Context, opts *ListSubredditOptions) ([]*Subreddit, *Response, error) {
 	return s.getSubreddits(ctx, "subreddits/gold", opts)
 }
 
+// Premium returns premium subreddits (i.e. only accessible to users with Reddit Premium).
+// It returns an empty list if you don't have Reddit Premium.
+func (s *SubredditService) Premium(ctx context.Context, opts *ListSubredditOptions) ([]*Subreddit, *Response, error) {
+	return s.getSubreddits(ctx, "subreddits/premium", opts)
+}
+
 // Default returns default subreddits.
 func (s *SubredditService) Default(ctx context.Context, opts *ListSubredditOptions) ([]*Subreddit, *Response, error) {
 	return s.getSubreddits(ctx, "subreddits/default", opts)
@@ -412,12 +497,132 @@ func (s *SubredditService) Moderated(ctx context.Context, opts *ListSubredditOpt
 	return s.getSubreddits(ctx, "subreddits/mine/moderator", opts)
 }
 
-// GetSticky1 returns the first stickied post on a subreddit (if it exists).
+// Stickies returns the stickied posts of a subreddit, in the order they appear in.
+// A subreddit can have up to 2 stickied posts.
+func (s *SubredditService) Stickies(ctx context.Context, subreddit string) ([]*Post, *Response, error) {
+	var posts []*Post
+	var resp *Response
+
+	for num := 1; num <= 2; num++ {
+		postAndComments, r, err := s.getSticky(ctx, subreddit, num)
+		resp = r
+		if _, ok := err.(*NoStickyError); ok {
+			// the 2nd slot can't be filled if the 1st one is empty
+			break
+		}
+		if err != nil {
+			return nil, resp, err
+		}
+		posts = append(posts, postAndComments.Post)
+	}
+
+	return posts, resp, nil
+}
+
+// SwapStickies swaps the 2 stickied posts of the subreddit, so that the top sticky becomes
+// the bottom one and vice versa. Both posts are unstickied and then re-stickied in the new order,
+// so if one of the requests fails, the subreddit might be left with fewer stickied posts.
+func (s *SubredditService) SwapStickies(ctx context.Context, subreddit string) (*Response, error) {
+	stickies, resp, err := s.Stickies(ctx, subreddit)
+	if err != nil {
+		return resp, err
+	}
+	if len(stickies) != 2 {
+		return resp, errors.New("subreddit must have 2 stickied posts")
+	}
+
+	top, bottom := stickies[0].FullID, stickies[1].FullID
+
+	for _, id := range []string{top, bottom} {
+		resp, err = s.client.Post.Unsticky(ctx, id)
+		if err != nil {
+			return resp, err
+		}
+	}
+
+	resp, err = s.client.Post.Sticky(ctx, bottom, false)
+	if err != nil {
+		return resp, err
+	}
+
+	return s.client.Post.Sticky(ctx, top, true)
+}
+
+// SetStickies makes the posts, in order, the stickied posts of the subreddit.
+// At most 2 posts can be stickied; providing none unstickies the current ones.
+// Posts that are already stickied are kept whenever possible, to make as few requests as possible,
+// e.g. going from [A, B] to [B, C] only unstickies A (B moving up to the top slot) and stickies C.
+func (s *SubredditService) SetStickies(ctx context.Context, subreddit string, ids ...string) (*Response, error) {
+	if len(ids) > 2 {
+		return nil, errors.New("cannot sticky more than 2 posts")
+	}
+
+	stickies, resp, err := s.Stickies(ctx, subreddit)
+	if err != nil {
+		return resp, err
+	}
+
+	var current []string
+	for _, post := range stickies {
+		current = append(current, post.FullID)
+	}
+
+	// unstickying a post moves the ones below it up, and new stickies are added at the bottom,
+	// so the current stickies can be kept if they appear, in order, at the start of the new ones
+	kept := len(ids)
+	if kept > len(current) {
+		kept = len(current)
+	}
+	for ; kept > 0; kept-- {
+		if isSubsequence(ids[:kept], current) {
+			break
+		}
+	}
+
+	keep := make(map[string]bool)
+	for _, id := range ids[:kept] {
+		keep[id] = true
+	}
+
+	for _, id := range current {
+		if keep[id] {
+			continue
+		}
+		resp, err = s.client.Post.Unsticky(ctx, id)
+		if err != nil {
+			return resp, err
+		}
+	}
+
+	for i := kept; i < len(ids); i++ {
+		resp, err = s.client.Post.Sticky(ctx, ids[i], i > 0)
+		if err != nil {
+			return resp, err
+		}
+	}
+
+	return resp, nil
+}
+
+// isSubsequence returns whether the elements of a appear in b in the same order.
+func isSubsequence(a, b []string) bool {
+	i := 0
+	for _, s := range b {
+		if i < len(a) && a[i] == s {
+			i++
+		}
+	}
+	return i == len(a)
+}
+
+// GetSticky1 returns the first stickied post on a subreddit.
+// If it doesn't exist, a *NoStickyError is returned.
 func (s *SubredditService) GetSticky1(ctx context.Context, subreddit string) (*PostAndComments, *Response, error) {
 	return s.getSticky(ctx, subreddit, 1)
 }
 
-// GetSticky2 returns the second stickied post on a subreddit (if it exists).
+// GetSticky2 returns the second stickied post on a subreddit.
+// If it doesn't exist, a *NoStickyError is returned.
 func (s *SubredditService) GetSticky2(ctx context.Context, subreddit string) (*PostAndComments, *Response, error) {
 	return s.getSticky(ctx, subreddit, 2)
 }
@@ -439,6 +644,17 @@ func (s *SubredditService) Subscribe(ctx context.Context, subreddits ...string)
 	return s.handleSubscription(ctx, form)
 }
 
+// SubscribeSkipDefaults subscribes to subreddits based on their names.
+// When subscribing to a subreddit for the first time, Reddit also subscribes the account
+// to its default subreddits. This skips that, which is useful for onboarding flows.
+func (s *SubredditService) SubscribeSkipDefaults(ctx context.Context, subreddits ...string) (*Response, error) {
+	form := url.Values{}
+	form.Set("action", "sub")
+	form.Set("sr_name", strings.Join(subreddits, ","))
+	form.Set("skip_initial_defaults", "true")
+	return s.handleSubscription(ctx, form)
+}
+
 // SubscribeByID subscribes to subreddits based on their id.
 func (s *SubredditService) SubscribeByID(ctx context.Context, ids ...string) (*Response, error) {
 	form := url.Values{}
@@ -463,6 +679,29 @@ func (s *SubredditService) UnsubscribeByID(ctx context.Context, ids ...string) (
 	return s.handleSubscription(ctx, form)
 }
 
+// OptInQuarantine opts in to view the content of a quarantined subreddit.
+// Until you do, requests to the subreddit fail with a *QuarantinedError.
+func (s *SubredditService) OptInQuarantine(ctx context.Context, subreddit string) (*Response, error) {
+	return s.handleQuarantine(ctx, "api/quarantine_optin", subreddit)
+}
+
+// OptOutQuarantine opts out of viewing the content of a quarantined subreddit.
+func (s *SubredditService) OptOutQuarantine(ctx context.Context, subreddit string) (*Response, error) {
+	return s.handleQuarantine(ctx, "api/quarantine_optout", subreddit)
+}
+
+func (s *SubredditService) handleQuarantine(ctx context.Context, path string, subreddit string) (*Response, error) {
+	form := url.Values{}
+	form.Set("sr_name", subreddit)
+
+	req, err := s.client.NewRequest(http.MethodPost, path, form)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
 // Favorite the subreddit.
 func (s *SubredditService) Favorite(ctx context.Context, subreddit string) (*Response, error) {
 	path := "api/favorite"
@@ -509,7 +748,14 @@ func (s *SubredditService) Search(ctx context.Context, query string, opts *ListS
 
 // SearchNames searches for subreddits with names beginning with the query provided.
 func (s *SubredditService) SearchNames(ctx context.Context, query string) ([]string, *Response, error) {
-	path := fmt.Sprintf("api/search_reddit_names?query=%s", query)
+	params := struct {
+		Query string `url:"query"`
+	}{query}
+
+	path, err := addOptions("api/search_reddit_names", params)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	req, err := s.client.NewRequest(http.MethodGet, path, nil)
 	if err != nil {
@@ -525,10 +771,73 @@ func (s *SubredditService) SearchNames(ctx context.Context, query string) ([]str
 	return root.Names, resp, nil
 }
 
+// Autocomplete returns the subreddits whose names best complete the query, as suggested
+// by Reddit's search bar. Unlike SearchNames, it returns the subreddits themselves.
+func (s *SubredditService) Autocomplete(ctx context.Context, query string, opts *ListSubredditAutocompleteOptions) ([]*Subreddit, *Response, error) {
+	params := struct {
+		Query string `url:"query"`
+	}{query}
+
+	path, err := addOptions("api/subreddit_autocomplete_v2", params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	l, resp, err := s.client.getListing(ctx, path, opts)
+	if err != nil {
+		return nil, resp, err
+	}
+	return l.Subreddits(), resp, nil
+}
+
+// Recommended returns the names of subreddits similar to the ones provided,
+// excluding the ones in omit.
+func (s *SubredditService) Recommended(ctx context.Context, subreddits []string, omit []string) ([]string, *Response, error) {
+	if len(subreddits) == 0 {
+		return nil, nil, errors.New("must provide at least 1 subreddit")
+	}
+
+	params := struct {
+		Omit string `url:"omit,omitempty"`
+	}{strings.Join(omit, ",")}
+
+	path := fmt.Sprintf("api/recommend/sr/%s", strings.Join(subreddits, ","))
+	path, err := addOptions(path, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var root []struct {
+		Name string `json:"sr_name"`
+	}
+	resp, err := s.client.Do(ctx, req, &root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	names := make([]string, 0, len(root))
+	for _, r := range root {
+		names = append(names, r.Name)
+	}
+
+	return names, resp, nil
+}
+
 // SearchPosts searches for posts in the specified subreddit.
 // To search through multiple, separate the names with a plus (+), e.g. "golang+test".
 // If no subreddit is provided, the search is run against r/all.
 func (s *SubredditService) SearchPosts(ctx context.Context, query string, subreddit string, opts *ListPostSearchOptions) ([]*Post, *Response, error) {
+	if opts != nil {
+		if err := opts.validate(); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	if subreddit == "" {
 		subreddit = "all"
 	}
@@ -541,6 +850,10 @@ func (s *SubredditService) SearchPosts(ctx context.Context, query string, subred
 
 	notAll := !strings.EqualFold(subreddit, "all")
 
+	if opts != nil && opts.Flair != "" {
+		query = strings.TrimSpace(query + ` flair:"` + opts.Flair + `"`)
+	}
+
 	params := struct {
 		Query              string `url:"q"`
 		RestrictSubreddits bool   `url:"restrict_sr,omitempty"`
@@ -584,7 +897,7 @@ func (s *SubredditService) getSticky(ctx context.Context, subreddit string, num
 	root := new(PostAndComments)
 	resp, err := s.client.Do(ctx, req, root)
 	if err != nil {
-		return nil, resp, err
+		return nil, resp, asNoStickyError(err)
 	}
 
 	return root, resp, nil
@@ -640,6 +953,46 @@ func (s *SubredditService) RandomNSFW(ctx context.Context) (*Subreddit, *Respons
 	return s.random(ctx, true)
 }
 
+// SubredditSidebar is the text describing a subreddit.
+type SubredditSidebar struct {
+	// The sidebar of old Reddit.
+	Markdown string `json:"description,omitempty"`
+	HTML     string `json:"description_html,omitempty"`
+
+	// The short description shown in the "About Community" section of new Reddit.
+	PublicDescription     string `json:"public_description,omitempty"`
+	PublicDescriptionHTML string `json:"public_description_html,omitempty"`
+}
+
+// Sidebar gets the sidebar and description of the subreddit, in both markdown and HTML.
+// The sidebar of new Reddit is made of widgets, which can be obtained via WidgetService.Get.
+func (s *SubredditService) Sidebar(ctx context.Context, name string) (*SubredditSidebar, *Response, error) {
+	if name == "" {
+		return nil, nil, errors.New("name: cannot be empty")
+	}
+
+	path := fmt.Sprintf("r/%s/about", name)
+	req, err := s.client.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(struct {
+		Data SubredditSidebar `json:"data"`
+	})
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	// the HTML is escaped in Reddit's JSON responses
+	sidebar := &root.Data
+	sidebar.HTML = html.UnescapeString(sidebar.HTML)
+	sidebar.PublicDescriptionHTML = html.UnescapeString(sidebar.PublicDescriptionHTML)
+
+	return sidebar, resp, nil
+}
+
 // SubmissionText gets the submission text for the subreddit.
 // This text is set by the subreddit moderators and intended to be displayed on the submission form.
 func (s *SubredditService) SubmissionText(ctx context.Context, name string) (string, *Response, error) {
@@ -666,6 +1019,31 @@ func (s *SubredditService) SubmissionText(ctx context.Context, name string) (str
 
 // Banned gets banned users from the subreddit.
 func (s *SubredditService) Banned(ctx context.Context, subreddit string, opts *ListOptions) ([]*Ban, *Response, error) {
+	return s.banned(ctx, subreddit, opts)
+}
+
+// BannedUser gets the ban of a user from the subreddit.
+// If the user is not banned, the returned *Ban is nil.
+func (s *SubredditService) BannedUser(ctx context.Context, subreddit string, username string) (*Ban, *Response, error) {
+	opts := struct {
+		User string `url:"user"`
+	}{username}
+
+	bans, resp, err := s.banned(ctx, subreddit, opts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	for _, ban := range bans {
+		if strings.EqualFold(ban.User, username) {
+			return ban, resp, nil
+		}
+	}
+
+	return nil, resp, nil
+}
+
+func (s *SubredditService) banned(ctx context.Context, subreddit string, opts interface{}) ([]*Ban, *Response, error) {
 	path := fmt.Sprintf("r/%s/about/banned", subreddit)
 
 	path, err := addOptions(path, opts)
@@ -851,6 +1229,37 @@ func (s *SubredditService) Rules(ctx context.Context, subreddit string) ([]*Subr
 	return root.Rules, resp, nil
 }
 
+// ReportReasons gets the reasons that can be used when reporting posts and comments in the subreddit,
+// i.e. the violation reasons of the subreddit's rules, as well as Reddit's site-wide rules.
+func (s *SubredditService) ReportReasons(ctx context.Context, subreddit string) (*ReportReasons, *Response, error) {
+	path := fmt.Sprintf("r/%s/about/rules", subreddit)
+
+	req, err := s.client.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(struct {
+		Rules     []*SubredditRule `json:"rules"`
+		SiteRules []string         `json:"site_rules"`
+	})
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	reasons := &ReportReasons{SiteReasons: root.SiteRules}
+	for _, rule := range root.Rules {
+		reason := rule.ViolationReason
+		if reason == "" {
+			reason = rule.Name
+		}
+		reasons.RuleReasons = append(reasons.RuleReasons, reason)
+	}
+
+	return reasons, resp, nil
+}
+
 // CreateRule adds a rule to the subreddit.
 func (s *SubredditService) CreateRule(ctx context.Context, subreddit string, request *SubredditRuleCreateRequest) (*Response, error) {
 	err := request.validate()
@@ -1105,6 +1514,69 @@ func (s *SubredditService) UploadMobileIcon(ctx context.Context, subreddit, imag
 	return s.uploadImage(ctx, subreddit, imagePath, "icon", imageName)
 }
 
+// uploadStyleImage uploads an image of the subreddit's structured styles (i.e. its
+// new Reddit design) and sets it as the style of the given type.
+// A successful call returns a link to the uploaded image.
+func (s *SubredditService) uploadStyleImage(ctx context.Context, subreddit, imagePath, imageType string) (string, *Response, error) {
+	if err := checkMediaKind(imagePath, "image"); err != nil {
+		return "", nil, err
+	}
+
+	path := fmt.Sprintf("api/v1/style_asset_upload_s3/%s", subreddit)
+
+	form := url.Values{}
+	form.Set("imagetype", imageType)
+	form.Set("filepath", filepath.Base(imagePath))
+	form.Set("mimetype", mediaTypes[strings.ToLower(filepath.Ext(imagePath))])
+
+	req, err := s.client.NewRequest(http.MethodPost, path, form)
+	if err != nil {
+		return "", nil, err
+	}
+
+	root := new(struct {
+		Lease uploadLease `json:"s3UploadLease"`
+	})
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return "", resp, err
+	}
+
+	imageURL, resp, err := s.client.uploadWithLease(ctx, root.Lease, imagePath)
+	if err != nil {
+		return "", resp, err
+	}
+
+	path = fmt.Sprintf("api/v1/structured_styles/%s", subreddit)
+
+	form = url.Values{}
+	form.Set(imageType, imageURL)
+
+	req, err = s.client.NewRequest(http.MethodPatch, path, form)
+	if err != nil {
+		return "", nil, err
+	}
+
+	resp, err = s.client.Do(ctx, req, nil)
+	if err != nil {
+		return "", resp, err
+	}
+
+	return imageURL, resp, nil
+}
+
+// UploadIcon uploads an image and sets it as the subreddit's community icon, as seen on new Reddit.
+// A successful call returns a link to the uploaded image.
+func (s *SubredditService) UploadIcon(ctx context.Context, subreddit, imagePath string) (string, *Response, error) {
+	return s.uploadStyleImage(ctx, subreddit, imagePath, "communityIcon")
+}
+
+// UploadBanner uploads an image and sets it as the subreddit's banner, as seen on new Reddit.
+// A successful call returns a link to the uploaded image.
+func (s *SubredditService) UploadBanner(ctx context.Context, subreddit, imagePath string) (string, *Response, error) {
+	return s.uploadStyleImage(ctx, subreddit, imagePath, "bannerBackgroundImage")
+}
+
 // Create a subreddit.
 func (s *SubredditService) Create(ctx context.Context, name string, request *SubredditSettings) (*Response, error) {
 	if request == nil {
@@ -1152,6 +1624,32 @@ func (s *SubredditService) Edit(ctx context.Context, subredditID string, request
 	return s.client.Do(ctx, req, nil)
 }
 
+// UpdateSettings updates the settings of a subreddit.
+// Unlike Edit, only the settings to change need to be set in the request: the subreddit's
+// current settings are fetched, and the non-nil values of the request are applied on top of them.
+func (s *SubredditService) UpdateSettings(ctx context.Context, subreddit string, request *SubredditSettings) (*Response, error) {
+	if request == nil {
+		return nil, errors.New("*SubredditSettings: cannot be nil")
+	}
+
+	settings, resp, err := s.GetSettings(ctx, subreddit)
+	if err != nil {
+		return resp, err
+	}
+
+	// all fields are omitted from the JSON when nil, so decoding
+	// the request onto the current settings only overwrites the set ones
+	b, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(b, settings); err != nil {
+		return nil, err
+	}
+
+	return s.Edit(ctx, settings.ID, settings)
+}
+
 // GetSettings gets the settings of a subreddit.
 func (s *SubredditService) GetSettings(ctx context.Context, subreddit string) (*SubredditSettings, *Response, error) {
 	path := fmt.Sprintf("r/%s/about/edit", subreddit)
@@ -8,6 +8,8 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"path/filepath"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -20,7 +22,7 @@ var expectedPosts = []*Post{
 		ID:      "agi5zf",
 		FullID:  "t3_agi5zf",
 		Created: &Timestamp{time.Date(2019, 1, 16, 5, 57, 51, 0, time.UTC)},
-		Edited:  &Timestamp{time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)},
+		Edited:  &Edited{},
 
 		Permalink: "/r/test/comments/agi5zf/test/",
 		URL:       "https://www.reddit.com/r/test/comments/agi5zf/test/",
@@ -42,12 +44,14 @@ var expectedPosts = []*Post{
 
 		IsSelfPost: true,
 		Stickied:   true,
+
+		AllAwardings: []*Award{},
 	},
 	{
 		ID:      "hyhquk",
 		FullID:  "t3_hyhquk",
 		Created: &Timestamp{time.Date(2020, 7, 27, 0, 5, 10, 0, time.UTC)},
-		Edited:  &Timestamp{time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)},
+		Edited:  &Edited{},
 
 		Permalink: "/r/test/comments/hyhquk/veggies/",
 		URL:       "https://i.imgur.com/LrN2mPw.jpg",
@@ -65,6 +69,23 @@ var expectedPosts = []*Post{
 
 		Author:   "MuckleMcDuckle",
 		AuthorID: "t2_6fqntbwq",
+
+		Preview: &PostPreview{
+			Images: []*PostPreviewImage{
+				{
+					ID:     "bxde3rpzP-mqawZJwpBIzEiH1y9nOLW3n1ghq9FPAR8",
+					Source: &ImageSource{URL: "https://external-preview.redd.it/ljFZZBn60orDIFTvDbPCXM-Thg9XsXAVm5kmH62gxKw.png?auto=webp&amp;s=f5103946eee4586cba8a1ba410e3098e9a14bb58", Width: 720, Height: 859},
+					Resolutions: []*ImageSource{
+						{URL: "https://external-preview.redd.it/ljFZZBn60orDIFTvDbPCXM-Thg9XsXAVm5kmH62gxKw.png?width=108&amp;crop=smart&amp;auto=webp&amp;s=a6904af790568dcea8fd3566e5d469df88a3891d", Width: 108, Height: 128},
+						{URL: "https://external-preview.redd.it/ljFZZBn60orDIFTvDbPCXM-Thg9XsXAVm5kmH62gxKw.png?width=216&amp;crop=smart&amp;auto=webp&amp;s=09720b85b3b469b37030db3e3a5ab7fa231480f9", Width: 216, Height: 257},
+						{URL: "https://external-preview.redd.it/ljFZZBn60orDIFTvDbPCXM-Thg9XsXAVm5kmH62gxKw.png?width=320&amp;crop=smart&amp;auto=webp&amp;s=78ace2e1c15e0e82dcfc95574d3ea3756812fd98", Width: 320, Height: 381},
+						{URL: "https://external-preview.redd.it/ljFZZBn60orDIFTvDbPCXM-Thg9XsXAVm5kmH62gxKw.png?width=640&amp;crop=smart&amp;auto=webp&amp;s=d5d5305e3d97553176170ead8462cc0d155a7793", Width: 640, Height: 763},
+					},
+				},
+			},
+			Enabled: true,
+		},
+		AllAwardings: []*Award{},
 	},
 }
 
@@ -80,6 +101,22 @@ var expectedSubreddit = &Subreddit{
 	Description:  "Ask questions and post articles about the Go programming language and related tools, events etc.",
 	Type:         "public",
 
+	CommunityIconURL: "https://styles.redditmedia.com/t5_2rc7j/styles/communityIcon_wy4riduoe9k11.png?width=256&amp;s=0d681daaa8d4b6271e6be788d0f9379f0661e04a",
+	HeaderURL:        "https://b.thumbs.redditmedia.com/7BDtSXbohQaPFuaa6oCA5HtE53Flgld6rj3G7-TavDs.png",
+	BannerURL:        "https://styles.redditmedia.com/t5_2rc7j/styles/bannerBackgroundImage_k15p9ugyd9k11.png?width=4000&amp;s=dc19f23446f14c3dee0ab59c538fd5dfb243eeb9",
+	Language:         "en",
+
+	SubmissionType:   "any",
+	AllowImages:      true,
+	AllowVideos:      true,
+	AllowGalleries:   true,
+	AllowPolls:       true,
+	SpoilersEnabled:  true,
+	AllowVideoGIFs:   true,
+	RestrictPosting:  true,
+	Crosspostable:    true,
+	LinkFlairEnabled: true,
+
 	Subscribers:     116532,
 	ActiveUserCount: Int(386),
 	NSFW:            false,
@@ -99,6 +136,18 @@ var expectedSubreddits = []*Subreddit{
 		Title:        "Home",
 		Type:         "public",
 
+		Language: "en",
+
+		SubmissionType:  "any",
+		AllowImages:     true,
+		AllowVideos:     true,
+		AllowGalleries:  true,
+		AllowPolls:      true,
+		SpoilersEnabled: true,
+		AllowVideoGIFs:  true,
+		RestrictPosting: true,
+		Crosspostable:   true,
+
 		Subscribers: 15336,
 		NSFW:        false,
 		UserIsMod:   false,
@@ -117,6 +166,21 @@ var expectedSubreddits = []*Subreddit{
 		Description:  "r/AskReddit is the place to ask and answer thought-provoking questions.",
 		Type:         "public",
 
+		IconURL:          "https://b.thumbs.redditmedia.com/EndDxMGB-FTZ2MGtjepQ06cQEkZw_YQAsOUudpb9nSQ.png",
+		CommunityIconURL: "https://styles.redditmedia.com/t5_2qh1i/styles/communityIcon_tijjpyw1qe201.png?width=256&amp;s=4e76eadc662b8155a93d4d7487a6d3acb35f4334",
+		HeaderURL:        "https://a.thumbs.redditmedia.com/IrfPJGuWzi_ewrDTBlnULeZsJYGz81hsSQoQJyw6LD8.png",
+		PrimaryColor:     "#646d73",
+		KeyColor:         "#222222",
+		Language:         "es",
+
+		SubmissionType:   "self",
+		AllowGalleries:   true,
+		SpoilersEnabled:  true,
+		RestrictPosting:  true,
+		WikiEnabled:      true,
+		EmojisEnabled:    true,
+		LinkFlairEnabled: true,
+
 		Subscribers: 28449174,
 		NSFW:        false,
 		UserIsMod:   false,
@@ -135,6 +199,23 @@ var expectedSubreddits = []*Subreddit{
 		Description:  "A place for pictures and photographs.",
 		Type:         "public",
 
+		IconURL:      "https://b.thumbs.redditmedia.com/VZX_KQLnI1DPhlEZ07bIcLzwR1Win808RIt7zm49VIQ.png",
+		HeaderURL:    "https://b.thumbs.redditmedia.com/1zT3FeN8pCAFIooNVuyuZ0ObU0x1ro4wPfArGHl3KjM.png",
+		PrimaryColor: "#cee3f8",
+		KeyColor:     "#222222",
+		Language:     "en",
+
+		SubmissionType:            "link",
+		AllowImages:               true,
+		AllowGalleries:            true,
+		SpoilersEnabled:           true,
+		AllowVideoGIFs:            true,
+		RestrictPosting:           true,
+		Crosspostable:             true,
+		WikiEnabled:               true,
+		OriginalContentTagEnabled: true,
+		LinkFlairEnabled:          true,
+
 		Subscribers: 24987753,
 		NSFW:        false,
 		UserIsMod:   false,
@@ -158,7 +239,8 @@ var expectedSearchPosts = []*Post{
 		ID:      "hybow9",
 		FullID:  "t3_hybow9",
 		Created: &Timestamp{time.Date(2020, 7, 26, 18, 14, 24, 0, time.UTC)},
-		Edited:  &Timestamp{time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)},
+		Edited:  &Edited{},
+		IsVideo: true,
 
 		Permalink: "/r/WatchPeopleDieInside/comments/hybow9/pregnancy_test/",
 		URL:       "https://v.redd.it/ra4qnt8bt8d51",
@@ -176,12 +258,160 @@ var expectedSearchPosts = []*Post{
 
 		Author:   "chocolat_ice_cream",
 		AuthorID: "t2_3p32m02",
+
+		Preview: &PostPreview{
+			Images: []*PostPreviewImage{
+				{
+					ID:     "6MEEtWN_cm1lRDpu_daXxHcau23YIWh0FeiB96IPgJs",
+					Source: &ImageSource{URL: "https://external-preview.redd.it/OcR_yQzvFMo4upwEVJe0naWpvA3cmyBpucsJF2OvhLA.png?format=pjpg&amp;auto=webp&amp;s=dbe1004d6df4fb6014d78e0c0d817c1106f1f3b2", Width: 360, Height: 360},
+					Resolutions: []*ImageSource{
+						{URL: "https://external-preview.redd.it/OcR_yQzvFMo4upwEVJe0naWpvA3cmyBpucsJF2OvhLA.png?width=108&amp;crop=smart&amp;format=pjpg&amp;auto=webp&amp;s=3de4a7249f291b848838f865bb592f7e51555e96", Width: 108, Height: 108},
+						{URL: "https://external-preview.redd.it/OcR_yQzvFMo4upwEVJe0naWpvA3cmyBpucsJF2OvhLA.png?width=216&amp;crop=smart&amp;format=pjpg&amp;auto=webp&amp;s=531916387899ed20e33386081b5d5c58a73be188", Width: 216, Height: 216},
+						{URL: "https://external-preview.redd.it/OcR_yQzvFMo4upwEVJe0naWpvA3cmyBpucsJF2OvhLA.png?width=320&amp;crop=smart&amp;format=pjpg&amp;auto=webp&amp;s=4d19996fba95dae7fb615cdc102d34c8bfb44e0a", Width: 320, Height: 320},
+					},
+				},
+			},
+		},
+		Media: &PostMedia{
+			RedditVideo: &RedditVideo{
+				FallbackURL:       "https://v.redd.it/ra4qnt8bt8d51/DASH_360.mp4?source=fallback",
+				DASHURL:           "https://v.redd.it/ra4qnt8bt8d51/DASHPlaylist.mpd?a=1598576219%2CZjZhYTZlMTYxOTU2MjQzNTBlMmZmMjRiNDRlNDYxM2NjNjZiZjM2NzQxYTA5MTdhMGQyODBmNGJiYjYyOGFjMw%3D%3D&amp;v=1&amp;f=sd",
+				HLSURL:            "https://v.redd.it/ra4qnt8bt8d51/HLSPlaylist.m3u8?a=1598576219%2CNTlmNTJhZDAyMTY4ZDAzNmM1NzAxMTYxZTNmYTk1OTJkYzI3MWEyYjNmNDdmYWU2MWY5ZjUwMzFkODA2YWY1ZQ%3D%3D&amp;v=1&amp;f=sd",
+				ScrubberMediaURL:  "https://v.redd.it/ra4qnt8bt8d51/DASH_96.mp4",
+				TranscodingStatus: "completed",
+				Width:             360,
+				Height:            360,
+				Duration:          230,
+			},
+		},
+		SecureMedia: &PostMedia{
+			RedditVideo: &RedditVideo{
+				FallbackURL:       "https://v.redd.it/ra4qnt8bt8d51/DASH_360.mp4?source=fallback",
+				DASHURL:           "https://v.redd.it/ra4qnt8bt8d51/DASHPlaylist.mpd?a=1598576219%2CZjZhYTZlMTYxOTU2MjQzNTBlMmZmMjRiNDRlNDYxM2NjNjZiZjM2NzQxYTA5MTdhMGQyODBmNGJiYjYyOGFjMw%3D%3D&amp;v=1&amp;f=sd",
+				HLSURL:            "https://v.redd.it/ra4qnt8bt8d51/HLSPlaylist.m3u8?a=1598576219%2CNTlmNTJhZDAyMTY4ZDAzNmM1NzAxMTYxZTNmYTk1OTJkYzI3MWEyYjNmNDdmYWU2MWY5ZjUwMzFkODA2YWY1ZQ%3D%3D&amp;v=1&amp;f=sd",
+				ScrubberMediaURL:  "https://v.redd.it/ra4qnt8bt8d51/DASH_96.mp4",
+				TranscodingStatus: "completed",
+				Width:             360,
+				Height:            360,
+				Duration:          230,
+			},
+		},
+		AllAwardings: []*Award{
+			{
+				ID:          "award_9663243a-e77f-44cf-abc6-850ead2cd18d",
+				Name:        "Bravo Grande!",
+				Description: "For an especially amazing showing.",
+				IconURL:     "https://www.redditstatic.com/gold/awards/icon/SnooClappingPremium_512.png",
+				Type:        "global",
+				CoinPrice:   75,
+				Count:       1,
+			},
+			{
+				ID:          "award_a2506925-fc82-4d6c-ae3b-b7217e09d7f0",
+				Name:        "Narwhal Salute",
+				Description: "A golden splash of respect",
+				IconURL:     "https://i.redd.it/award_images/t5_22cerq/80j20o397jj41_NarwhalSalute.png",
+				Type:        "global",
+				CoinPrice:   30,
+				Count:       1,
+			},
+			{
+				ID:          "award_b4ff447e-05a5-42dc-9002-63568807cfe6",
+				Name:        "All-Seeing Upvote",
+				Description: "A glowing commendation for all to see",
+				IconURL:     "https://i.redd.it/award_images/t5_22cerq/rg960rc47jj41_All-SeeingUpvote.png",
+				Type:        "global",
+				CoinPrice:   30,
+				Count:       2,
+			},
+			{
+				ID:          "gid_3",
+				Name:        "Platinum",
+				Description: "Gives the author a month of Reddit Premium, which includes %{coin_symbol}700 Coins for that month, and shows a Platinum Award.",
+				IconURL:     "https://www.redditstatic.com/gold/awards/icon/platinum_512.png",
+				Type:        "global",
+				CoinPrice:   1800,
+				Count:       2,
+			},
+			{
+				ID:          "gid_2",
+				Name:        "Gold",
+				Description: "Gives the author a week of Reddit Premium, %{coin_symbol}100 Coins to do with as they please, and shows a Gold Award.",
+				IconURL:     "https://www.redditstatic.com/gold/awards/icon/gold_512.png",
+				Type:        "global",
+				CoinPrice:   500,
+				Count:       4,
+			},
+			{
+				ID:          "award_b28d9565-4137-433d-bb65-5d4aa82ade4c",
+				Name:        "I'm Deceased",
+				Description: "Call an ambulance, I'm laughing too hard.",
+				IconURL:     "https://i.redd.it/award_images/t5_22cerq/2jd92wtn25g41_ImDeceased.png",
+				Type:        "global",
+				CoinPrice:   200,
+				Count:       3,
+			},
+			{
+				ID:          "award_88fdcafc-57a0-48db-99cc-76276bfaf28b",
+				Name:        "Press F",
+				Description: "To pay respects.",
+				IconURL:     "https://i.redd.it/award_images/t5_22cerq/tcofsbf92md41_PressF.png",
+				Type:        "global",
+				CoinPrice:   150,
+				Count:       1,
+			},
+			{
+				ID:          "award_77ba55a2-c33c-4351-ac49-807455a80148",
+				Name:        "Bless Up",
+				Description: "Prayers up for the blessed.",
+				IconURL:     "https://i.redd.it/award_images/t5_22cerq/trfv6ems1md41_BlessUp.png",
+				Type:        "global",
+				CoinPrice:   150,
+				Count:       1,
+			},
+			{
+				ID:          "gid_1",
+				Name:        "Silver",
+				Description: "Shows the Silver Award... and that's it.",
+				IconURL:     "https://www.redditstatic.com/gold/awards/icon/silver_512.png",
+				Type:        "global",
+				CoinPrice:   100,
+				Count:       1,
+			},
+			{
+				ID:          "award_7becef23-fb0b-4d62-b8a6-01d5759367cb",
+				Name:        "Faith In Humanity Restored",
+				Description: "When goodness lifts you",
+				IconURL:     "https://i.redd.it/award_images/t5_22cerq/gva4vt20qc751_FaithInHumanityRestored.png",
+				Type:        "global",
+				CoinPrice:   70,
+				Count:       1,
+			},
+			{
+				ID:          "award_02d9ab2c-162e-4c01-8438-317a016ed3d9",
+				Name:        "Take My Energy",
+				Description: "I'm in this with you.",
+				IconURL:     "https://i.redd.it/award_images/t5_22cerq/898sygoknoo41_TakeMyEnergy.png",
+				Type:        "global",
+				CoinPrice:   50,
+				Count:       5,
+			},
+			{
+				ID:          "award_69c94eb4-d6a3-48e7-9cf2-0f39fed8b87c",
+				Name:        "Ally",
+				Description: "Listen, get educated, and get involved.",
+				IconURL:     "https://i.redd.it/award_images/t5_22cerq/5nswjpyy44551_Ally.png",
+				Type:        "global",
+				CoinPrice:   50,
+				Count:       1,
+			},
+		},
 	},
 	{
 		ID:      "hmwhd7",
 		FullID:  "t3_hmwhd7",
 		Created: &Timestamp{time.Date(2020, 7, 7, 15, 19, 42, 0, time.UTC)},
-		Edited:  &Timestamp{time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)},
+		Edited:  &Edited{},
 
 		Permalink: "/r/worldnews/comments/hmwhd7/brazilian_president_jair_bolsonaro_tests_positive/",
 		URL:       "https://www.theguardian.com/world/2020/jul/07/jair-bolsonaro-coronavirus-positive-test-brazil-president",
@@ -199,6 +429,243 @@ var expectedSearchPosts = []*Post{
 
 		Author:   "Jeremy_Martin",
 		AuthorID: "t2_wgrkg",
+
+		LinkFlairText:     "COVID-19",
+		LinkFlairCSSClass: "coronavirus",
+		Preview: &PostPreview{
+			Images: []*PostPreviewImage{
+				{
+					ID:     "Ug52cYq0iihKhNVnhJnu_b8ThcVTp27Yjit2korgoUo",
+					Source: &ImageSource{URL: "https://external-preview.redd.it/OIVJopP4J8t4KzYcr7bjitC4Xd8CVbOHdNJcyz27viw.jpg?auto=webp&amp;s=bcb266e3d2f9b1b8410b8ebc1ba112461ac7c89b", Width: 1200, Height: 630},
+					Resolutions: []*ImageSource{
+						{URL: "https://external-preview.redd.it/OIVJopP4J8t4KzYcr7bjitC4Xd8CVbOHdNJcyz27viw.jpg?width=108&amp;crop=smart&amp;auto=webp&amp;s=8cd17cff83d56ad74566088b46a5f656c4e6233b", Width: 108, Height: 56},
+						{URL: "https://external-preview.redd.it/OIVJopP4J8t4KzYcr7bjitC4Xd8CVbOHdNJcyz27viw.jpg?width=216&amp;crop=smart&amp;auto=webp&amp;s=279340e68ef64a890709218d27e805e40ef2d1d5", Width: 216, Height: 113},
+						{URL: "https://external-preview.redd.it/OIVJopP4J8t4KzYcr7bjitC4Xd8CVbOHdNJcyz27viw.jpg?width=320&amp;crop=smart&amp;auto=webp&amp;s=a57f95db845046e7d75af256fed8a2fab65dec60", Width: 320, Height: 168},
+						{URL: "https://external-preview.redd.it/OIVJopP4J8t4KzYcr7bjitC4Xd8CVbOHdNJcyz27viw.jpg?width=640&amp;crop=smart&amp;auto=webp&amp;s=6fc8a7055610d03faaa3b0f32ba521a99b5c2bdd", Width: 640, Height: 336},
+						{URL: "https://external-preview.redd.it/OIVJopP4J8t4KzYcr7bjitC4Xd8CVbOHdNJcyz27viw.jpg?width=960&amp;crop=smart&amp;auto=webp&amp;s=be77436ac80c45b2153de325008085920d8d8489", Width: 960, Height: 504},
+						{URL: "https://external-preview.redd.it/OIVJopP4J8t4KzYcr7bjitC4Xd8CVbOHdNJcyz27viw.jpg?width=1080&amp;crop=smart&amp;auto=webp&amp;s=71644306bcb0036f2d8ee5bf878e3c78f6c3012c", Width: 1080, Height: 567},
+					},
+				},
+			},
+		},
+		AllAwardings: []*Award{
+			{
+				ID:          "award_6001deaa-c9e0-4914-ab3d-7c4a16bd8617",
+				Name:        "Fireworks",
+				Description: "Bonfires and illuminations are still going strong. Happy 4th of July!",
+				IconURL:     "https://www.redditstatic.com/gold/awards/icon/Fireworks_512.png",
+				Type:        "global",
+				CoinPrice:   100,
+				Count:       1,
+			},
+			{
+				ID:          "award_92cb6518-a71a-4217-9f8f-7ecbd7ab12ba",
+				Name:        "Take My Power",
+				Description: "Add my power to yours.",
+				IconURL:     "https://www.redditstatic.com/gold/awards/icon/TakeMyPower_512.png",
+				Type:        "global",
+				CoinPrice:   75,
+				Count:       2,
+			},
+			{
+				ID:          "award_9663243a-e77f-44cf-abc6-850ead2cd18d",
+				Name:        "Bravo Grande!",
+				Description: "For an especially amazing showing.",
+				IconURL:     "https://www.redditstatic.com/gold/awards/icon/SnooClappingPremium_512.png",
+				Type:        "global",
+				CoinPrice:   75,
+				Count:       1,
+			},
+			{
+				ID:          "award_c4b2e438-16bb-4568-88e7-7893b7662944",
+				Name:        "Wholesome Seal of Approval",
+				Description: "A glittering stamp for a feel-good thing",
+				IconURL:     "https://i.redd.it/award_images/t5_22cerq/b9ks3a5k7jj41_WholesomeSealofApproval.png",
+				Type:        "global",
+				CoinPrice:   30,
+				Count:       1,
+			},
+			{
+				ID:          "award_b4ff447e-05a5-42dc-9002-63568807cfe6",
+				Name:        "All-Seeing Upvote",
+				Description: "A glowing commendation for all to see",
+				IconURL:     "https://i.redd.it/award_images/t5_22cerq/rg960rc47jj41_All-SeeingUpvote.png",
+				Type:        "global",
+				CoinPrice:   30,
+				Count:       2,
+			},
+			{
+				ID:          "award_d48aad4b-286f-4a3a-bb41-ec05b3cd87cc",
+				Name:        "Yas Queen",
+				Description: "YAAAAAAAAAAASSS.",
+				IconURL:     "https://i.redd.it/award_images/t5_22cerq/kthj3e4h3bm41_YasQueen.png",
+				Type:        "global",
+				CoinPrice:   250,
+				Count:       2,
+			},
+			{
+				ID:          "gid_3",
+				Name:        "Platinum",
+				Description: "Gives the author a month of Reddit Premium, which includes %{coin_symbol}700 Coins for that month, and shows a Platinum Award.",
+				IconURL:     "https://www.redditstatic.com/gold/awards/icon/platinum_512.png",
+				Type:        "global",
+				CoinPrice:   1800,
+				Count:       1,
+			},
+			{
+				ID:          "gid_2",
+				Name:        "Gold",
+				Description: "Gives the author a week of Reddit Premium, %{coin_symbol}100 Coins to do with as they please, and shows a Gold Award.",
+				IconURL:     "https://www.redditstatic.com/gold/awards/icon/gold_512.png",
+				Type:        "global",
+				CoinPrice:   500,
+				Count:       3,
+			},
+			{
+				ID:          "award_43c43a35-15c5-4f73-91ef-fe538426435a",
+				Name:        "Bless Up (Pro)",
+				Description: "Prayers up for the blessed. Gives %{coin_symbol}100 Coins to both the author and the community.",
+				IconURL:     "https://i.redd.it/award_images/t5_22cerq/xe5mw55w5v541_BlessUp.png",
+				Type:        "global",
+				CoinPrice:   500,
+				Count:       1,
+			},
+			{
+				ID:          "award_5b39e8fd-7a58-4cbe-8ca0-bdedd5ed1f5a",
+				Name:        "Doot 🎵 Doot",
+				Description: "Sometimes you just got to dance with the doots.",
+				IconURL:     "https://www.redditstatic.com/gold/awards/icon/Updoot_512.png",
+				Type:        "global",
+				CoinPrice:   400,
+				Count:       6,
+			},
+			{
+				ID:          "award_725b427d-320b-4d02-8fb0-8bb7aa7b78aa",
+				Name:        "Updoot",
+				Description: "Sometimes you just got to doot.",
+				IconURL:     "https://i.redd.it/award_images/t5_22cerq/7atjjqpy1mc41_Updoot.png",
+				Type:        "global",
+				CoinPrice:   300,
+				Count:       1,
+			},
+			{
+				ID:          "award_d125d124-5c03-490d-af3d-d07c462003da",
+				Name:        "Stonks Rising",
+				Description: "To the MOON.",
+				IconURL:     "https://i.redd.it/award_images/t5_22cerq/s5edqq9abef41_StonksRising.png",
+				Type:        "global",
+				CoinPrice:   200,
+				Count:       2,
+			},
+			{
+				ID:          "award_b28d9565-4137-433d-bb65-5d4aa82ade4c",
+				Name:        "I'm Deceased",
+				Description: "Call an ambulance, I'm laughing too hard.",
+				IconURL:     "https://i.redd.it/award_images/t5_22cerq/2jd92wtn25g41_ImDeceased.png",
+				Type:        "global",
+				CoinPrice:   200,
+				Count:       7,
+			},
+			{
+				ID:          "award_88fdcafc-57a0-48db-99cc-76276bfaf28b",
+				Name:        "Press F",
+				Description: "To pay respects.",
+				IconURL:     "https://i.redd.it/award_images/t5_22cerq/tcofsbf92md41_PressF.png",
+				Type:        "global",
+				CoinPrice:   150,
+				Count:       4,
+			},
+			{
+				ID:          "award_5f123e3d-4f48-42f4-9c11-e98b566d5897",
+				Name:        "Wholesome",
+				Description: "When you come across a feel-good thing.",
+				IconURL:     "https://i.redd.it/award_images/t5_22cerq/5izbv4fn0md41_Wholesome.png",
+				Type:        "global",
+				CoinPrice:   125,
+				Count:       5,
+			},
+			{
+				ID:          "gid_1",
+				Name:        "Silver",
+				Description: "Shows the Silver Award... and that's it.",
+				IconURL:     "https://www.redditstatic.com/gold/awards/icon/silver_512.png",
+				Type:        "global",
+				CoinPrice:   100,
+				Count:       2,
+			},
+			{
+				ID:          "award_99d95969-6100-45b2-b00c-0ec45ae19596",
+				Name:        "Snek",
+				Description: "A smol, delicate danger noodle.",
+				IconURL:     "https://i.redd.it/award_images/t5_22cerq/rc5iesz2z8t41_Snek.png",
+				Type:        "global",
+				CoinPrice:   70,
+				Count:       1,
+			},
+			{
+				ID:          "award_7becef23-fb0b-4d62-b8a6-01d5759367cb",
+				Name:        "Faith In Humanity Restored",
+				Description: "When goodness lifts you",
+				IconURL:     "https://i.redd.it/award_images/t5_22cerq/gva4vt20qc751_FaithInHumanityRestored.png",
+				Type:        "global",
+				CoinPrice:   70,
+				Count:       2,
+			},
+			{
+				ID:          "award_b1b44fa1-8179-4d84-a9ed-f25bb81f1c5f",
+				Name:        "Facepalm",
+				Description: "*Lowers face into palm*",
+				IconURL:     "https://i.redd.it/award_images/t5_22cerq/ey2iodron2s41_Facepalm.png",
+				Type:        "global",
+				CoinPrice:   70,
+				Count:       3,
+			},
+			{
+				ID:          "award_02d9ab2c-162e-4c01-8438-317a016ed3d9",
+				Name:        "Take My Energy",
+				Description: "I'm in this with you.",
+				IconURL:     "https://i.redd.it/award_images/t5_22cerq/898sygoknoo41_TakeMyEnergy.png",
+				Type:        "global",
+				CoinPrice:   50,
+				Count:       2,
+			},
+			{
+				ID:          "award_fcccaa58-8f63-4d9d-9251-81033cd0daa3",
+				Name:        "Nothing To Do",
+				Description: "I've got nothing to do, and I'm trying to do nothing.",
+				IconURL:     "https://i.redd.it/award_images/t5_22cerq/1snr345pm1w41_NothingToDo.png",
+				Type:        "global",
+				CoinPrice:   50,
+				Count:       1,
+			},
+			{
+				ID:          "award_cc091963-e271-45aa-ba23-b5150e565520",
+				Name:        "Safe &amp; Social",
+				Description: "Connecting together responsibly",
+				IconURL:     "https://i.redd.it/award_images/t5_22cerq/qq73pijkm3p41_SafeSocial.png",
+				Type:        "global",
+				CoinPrice:   30,
+				Count:       1,
+			},
+			{
+				ID:          "award_3cf96da4-79da-4127-90ac-84545e1833dc",
+				Name:        "Home Time",
+				Description: "Staying home &amp; being safe when you can",
+				IconURL:     "https://i.redd.it/award_images/t5_22cerq/qh4pzo76v9p41_HomeTime.png",
+				Type:        "global",
+				CoinPrice:   30,
+				Count:       2,
+			},
+			{
+				ID:          "award_a903c949-ccc5-420d-8239-1bbefc424838",
+				Name:        "Healthcare Hero",
+				Description: "Putting yourself on the line for us - you are the perfect super hero!",
+				IconURL:     "https://i.redd.it/award_images/t5_22cerq/xs2na1t1v9p41_HealthcareHero.png",
+				Type:        "global",
+				CoinPrice:   30,
+				Count:       7,
+			},
+		},
 	},
 }
 
@@ -213,6 +680,13 @@ var expectedRandomSubreddit = &Subreddit{
 	Description:  "The only place for news, discussion, photos, and everything else Samsung Galaxy S8.",
 	Type:         "public",
 
+	IconURL:      "https://b.thumbs.redditmedia.com/4hg41g2_X1R5S_HTUscWCK_7iAo6SPdag_oOlSx7WAM.png",
+	HeaderURL:    "https://b.thumbs.redditmedia.com/AfySt3BMPjuq79LOh84X4uomahu0JE8DLaJZMenG-5I.png",
+	PrimaryColor: "#373c3f",
+
+	RestrictPosting:  true,
+	LinkFlairEnabled: true,
+
 	Subscribers: 52357,
 }
 
@@ -363,6 +837,8 @@ var expectedSubredditSettings = &SubredditSettings{
 	ExcludeSitewideBannedUsersContent: Bool(false),
 
 	CrowdControlChalLevel: Int(2),
+	CrowdControlMode:      Bool(false),
+	CrowdControlLevel:     Int(0),
 
 	AllOriginalContent: Bool(false),
 
@@ -451,6 +927,35 @@ func TestSubredditService_HotPosts(t *testing.T) {
 	require.Equal(t, "t3_hyhquk", resp.After)
 }
 
+func TestSubredditService_PopularPosts(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/subreddit/posts.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/r/popular/hot", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		form := url.Values{}
+		form.Set("limit", "2")
+		form.Set("g", "GB")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.Form)
+
+		fmt.Fprint(w, blob)
+	})
+
+	posts, resp, err := client.Subreddit.PopularPosts(ctx, &ListPopularPostOptions{
+		ListOptions: ListOptions{Limit: 2},
+		Region:      RegionUnitedKingdom,
+	})
+	require.NoError(t, err)
+	require.Equal(t, expectedPosts, posts)
+	require.Equal(t, "t3_hyhquk", resp.After)
+}
+
 func TestSubredditService_NewPosts(t *testing.T) {
 	client, mux := setup(t)
 
@@ -519,6 +1024,28 @@ func TestSubredditService_TopPosts(t *testing.T) {
 	require.Equal(t, "t3_hyhquk", resp.After)
 }
 
+func TestSubredditService_TopPosts_TimeFilter(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/subreddit/posts.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/r/test+golang/top", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, "month", r.Form.Get("t"))
+
+		fmt.Fprint(w, blob)
+	})
+
+	posts, resp, err := client.Subreddit.TopPosts(ctx, "test+golang", &ListPostOptions{Time: TimeFilterMonth})
+	require.NoError(t, err)
+	require.Equal(t, expectedPosts, posts)
+	require.Equal(t, "t3_hyhquk", resp.After)
+}
+
 func TestSubredditService_Get(t *testing.T) {
 	client, mux := setup(t)
 
@@ -538,6 +1065,60 @@ func TestSubredditService_Get(t *testing.T) {
 	require.Equal(t, expectedSubreddit, subreddit)
 }
 
+func TestSubredditService_ResolveFullIDs(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/subreddit/list.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/api/info", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		form := url.Values{}
+		form.Set("sr_name", "home,AskReddit,missing")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.Form)
+
+		fmt.Fprint(w, blob)
+	})
+
+	_, _, err = client.Subreddit.ResolveFullIDs(ctx)
+	require.EqualError(t, err, "must provide at least 1 name")
+
+	fullIDs, _, err := client.Subreddit.ResolveFullIDs(ctx, "home", "AskReddit", "missing")
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"home": "t5_2qs0k", "AskReddit": "t5_2qh1i"}, fullIDs)
+}
+
+func TestSubredditService_ResolveNames(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/subreddit/list.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/api/info", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		form := url.Values{}
+		form.Set("id", "t5_2qs0k,t5_2qh1i,t5_2qh0u")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.Form)
+
+		fmt.Fprint(w, blob)
+	})
+
+	_, _, err = client.Subreddit.ResolveNames(ctx, "t3_test")
+	require.EqualError(t, err, `"t3_test": must be the full ID of a subreddit`)
+
+	names, _, err := client.Subreddit.ResolveNames(ctx, "t5_2qs0k", "t5_2qh1i", "t5_2qh0u")
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"t5_2qs0k": "Home", "t5_2qh1i": "AskReddit", "t5_2qh0u": "pics"}, names)
+}
+
 func TestSubredditService_Popular(t *testing.T) {
 	client, mux := setup(t)
 
@@ -589,6 +1170,23 @@ func TestSubredditService_Gold(t *testing.T) {
 	require.Equal(t, "t5_2qh0u", resp.After)
 }
 
+func TestSubredditService_Premium(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/subreddit/list.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/subreddits/premium", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, blob)
+	})
+
+	subreddits, resp, err := client.Subreddit.Premium(ctx, nil)
+	require.NoError(t, err)
+	require.Equal(t, expectedSubreddits, subreddits)
+	require.Equal(t, "t5_2qh0u", resp.After)
+}
+
 func TestSubredditService_Default(t *testing.T) {
 	client, mux := setup(t)
 
@@ -678,6 +1276,19 @@ func TestSubredditService_GetSticky1(t *testing.T) {
 	require.Equal(t, expectedPostAndComments, postAndComments)
 }
 
+func TestSubredditService_GetSticky1_NotFound(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/r/test/about/sticky", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"message": "Not Found", "error": 404}`)
+	})
+
+	_, _, err := client.Subreddit.GetSticky1(ctx, "test")
+	require.IsType(t, &NoStickyError{}, err)
+}
+
 func TestSubredditService_GetSticky2(t *testing.T) {
 	client, mux := setup(t)
 
@@ -699,6 +1310,189 @@ func TestSubredditService_GetSticky2(t *testing.T) {
 	require.Equal(t, expectedPostAndComments, postAndComments)
 }
 
+func TestSubredditService_Stickies(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/post/post.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/r/test/about/sticky", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+
+		if r.Form.Get("num") == "2" {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"message": "Not Found", "error": 404}`)
+			return
+		}
+
+		require.Equal(t, "1", r.Form.Get("num"))
+		fmt.Fprint(w, blob)
+	})
+
+	posts, _, err := client.Subreddit.Stickies(ctx, "test")
+	require.NoError(t, err)
+	require.Equal(t, []*Post{expectedPostAndComments.Post}, posts)
+}
+
+func TestSubredditService_SwapStickies(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/post/post.json")
+	require.NoError(t, err)
+
+	stickies := []string{"t3_testpost", "t3_testpost2"}
+
+	mux.HandleFunc("/r/test/about/sticky", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+
+		switch r.Form.Get("num") {
+		case "1":
+			fmt.Fprint(w, strings.Replace(blob, "t3_testpost", stickies[0], 1))
+		case "2":
+			fmt.Fprint(w, strings.Replace(blob, "t3_testpost", stickies[1], 1))
+		}
+	})
+
+	var requests []url.Values
+	mux.HandleFunc("/api/set_subreddit_sticky", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		requests = append(requests, r.PostForm)
+	})
+
+	_, err = client.Subreddit.SwapStickies(ctx, "test")
+	require.NoError(t, err)
+	require.Equal(t, []url.Values{
+		{"api_type": {"json"}, "id": {"t3_testpost"}, "state": {"false"}},
+		{"api_type": {"json"}, "id": {"t3_testpost2"}, "state": {"false"}},
+		{"api_type": {"json"}, "id": {"t3_testpost2"}, "state": {"true"}, "num": {"1"}},
+		{"api_type": {"json"}, "id": {"t3_testpost"}, "state": {"true"}},
+	}, requests)
+
+	mux.HandleFunc("/r/test2/about/sticky", func(w http.ResponseWriter, r *http.Request) {
+		err := r.ParseForm()
+		require.NoError(t, err)
+
+		if r.Form.Get("num") == "2" {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"message": "Not Found", "error": 404}`)
+			return
+		}
+		fmt.Fprint(w, blob)
+	})
+
+	_, err = client.Subreddit.SwapStickies(ctx, "test2")
+	require.EqualError(t, err, "subreddit must have 2 stickied posts")
+}
+
+func TestSubredditService_SetStickies(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/post/post.json")
+	require.NoError(t, err)
+
+	var stickies []string
+	var requests int
+
+	mux.HandleFunc("/r/test/about/sticky", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+
+		num, err := strconv.Atoi(r.Form.Get("num"))
+		require.NoError(t, err)
+
+		if num > len(stickies) {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"message": "Not Found", "error": 404}`)
+			return
+		}
+		fmt.Fprint(w, strings.Replace(blob, "t3_testpost", stickies[num-1], 1))
+	})
+
+	mux.HandleFunc("/api/set_subreddit_sticky", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		requests++
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+
+		id := r.PostForm.Get("id")
+		switch {
+		case r.PostForm.Get("state") == "false":
+			for i, sticky := range stickies {
+				if sticky == id {
+					stickies = append(stickies[:i], stickies[i+1:]...)
+				}
+			}
+		case r.PostForm.Get("num") == "1" || len(stickies) == 0:
+			stickies = append([]string{id}, stickies...)
+		default:
+			stickies = append(stickies[:1], id)
+		}
+	})
+
+	_, err = client.Subreddit.SetStickies(ctx, "test", "t3_1", "t3_2", "t3_3")
+	require.EqualError(t, err, "cannot sticky more than 2 posts")
+
+	_, err = client.Subreddit.SetStickies(ctx, "test", "t3_1", "t3_2")
+	require.NoError(t, err)
+	require.Equal(t, []string{"t3_1", "t3_2"}, stickies)
+	require.Equal(t, 2, requests)
+
+	requests = 0
+	_, err = client.Subreddit.SetStickies(ctx, "test", "t3_1", "t3_3")
+	require.NoError(t, err)
+	require.Equal(t, []string{"t3_1", "t3_3"}, stickies)
+	require.Equal(t, 2, requests)
+
+	requests = 0
+	_, err = client.Subreddit.SetStickies(ctx, "test", "t3_1", "t3_3")
+	require.NoError(t, err)
+	require.Equal(t, []string{"t3_1", "t3_3"}, stickies)
+	require.Equal(t, 0, requests)
+
+	requests = 0
+	_, err = client.Subreddit.SetStickies(ctx, "test", "t3_3", "t3_1")
+	require.NoError(t, err)
+	require.Equal(t, []string{"t3_3", "t3_1"}, stickies)
+	require.Equal(t, 2, requests)
+
+	// rotating the stickies, e.g. for daily threads
+	requests = 0
+	_, err = client.Subreddit.SetStickies(ctx, "test", "t3_1", "t3_4")
+	require.NoError(t, err)
+	require.Equal(t, []string{"t3_1", "t3_4"}, stickies)
+	require.Equal(t, 2, requests)
+
+	requests = 0
+	_, err = client.Subreddit.SetStickies(ctx, "test", "t3_4")
+	require.NoError(t, err)
+	require.Equal(t, []string{"t3_4"}, stickies)
+	require.Equal(t, 1, requests)
+
+	requests = 0
+	_, err = client.Subreddit.SetStickies(ctx, "test", "t3_4", "t3_5")
+	require.NoError(t, err)
+	require.Equal(t, []string{"t3_4", "t3_5"}, stickies)
+	require.Equal(t, 1, requests)
+
+	requests = 0
+	_, err = client.Subreddit.SetStickies(ctx, "test")
+	require.NoError(t, err)
+	require.Empty(t, stickies)
+	require.Equal(t, 2, requests)
+}
+
 func TestSubredditService_Subscribe(t *testing.T) {
 	client, mux := setup(t)
 
@@ -718,6 +1512,26 @@ func TestSubredditService_Subscribe(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestSubredditService_SubscribeSkipDefaults(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/api/subscribe", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("action", "sub")
+		form.Set("sr_name", "test,golang,nba")
+		form.Set("skip_initial_defaults", "true")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+	})
+
+	_, err := client.Subreddit.SubscribeSkipDefaults(ctx, "test", "golang", "nba")
+	require.NoError(t, err)
+}
+
 func TestSubredditService_SubscribeByID(t *testing.T) {
 	client, mux := setup(t)
 
@@ -775,6 +1589,64 @@ func TestSubredditService_UnsubscribeByID(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestSubredditService_OptInQuarantine(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/api/quarantine_optin", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("sr_name", "test")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+	})
+
+	_, err := client.Subreddit.OptInQuarantine(ctx, "test")
+	require.NoError(t, err)
+}
+
+func TestSubredditService_OptOutQuarantine(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/api/quarantine_optout", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("sr_name", "test")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+	})
+
+	_, err := client.Subreddit.OptOutQuarantine(ctx, "test")
+	require.NoError(t, err)
+}
+
+func TestSubredditService_HotPosts_Quarantined(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/r/test/hot", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{
+			"reason": "quarantined",
+			"quarantine_message": "This community is quarantined.",
+			"message": "Forbidden",
+			"error": 403
+		}`)
+	})
+
+	_, _, err := client.Subreddit.HotPosts(ctx, "test", nil)
+	require.IsType(t, &QuarantinedError{}, err)
+
+	quarantinedErr := err.(*QuarantinedError)
+	require.Equal(t, "Forbidden", quarantinedErr.Message)
+	require.Equal(t, "This community is quarantined.", quarantinedErr.QuarantineMessage)
+}
+
 func TestSubredditService_Favorite(t *testing.T) {
 	client, mux := setup(t)
 
@@ -871,6 +1743,61 @@ func TestSubredditService_SearchNames(t *testing.T) {
 	require.Equal(t, expectedSubredditNames, names)
 }
 
+func TestSubredditService_Autocomplete(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/subreddit/list.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/api/subreddit_autocomplete_v2", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		form := url.Values{}
+		form.Set("query", "test query")
+		form.Set("limit", "3")
+		form.Set("include_over_18", "true")
+		form.Set("include_profiles", "true")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.Form)
+
+		fmt.Fprint(w, blob)
+	})
+
+	subreddits, _, err := client.Subreddit.Autocomplete(ctx, "test query", &ListSubredditAutocompleteOptions{
+		Limit:           3,
+		IncludeNSFW:     true,
+		IncludeProfiles: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, expectedSubreddits, subreddits)
+}
+
+func TestSubredditService_Recommended(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/api/recommend/sr/golang,rust", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		form := url.Values{}
+		form.Set("omit", "programming,java")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.Form)
+
+		fmt.Fprint(w, `[{"sr_name": "golang_infosec"}, {"sr_name": "learnrust"}]`)
+	})
+
+	_, _, err := client.Subreddit.Recommended(ctx, nil, nil)
+	require.EqualError(t, err, "must provide at least 1 subreddit")
+
+	names, _, err := client.Subreddit.Recommended(ctx, []string{"golang", "rust"}, []string{"programming", "java"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"golang_infosec", "learnrust"}, names)
+}
+
 func TestSubredditService_SearchPosts(t *testing.T) {
 	client, mux := setup(t)
 
@@ -896,6 +1823,41 @@ func TestSubredditService_SearchPosts(t *testing.T) {
 	require.Equal(t, "t3_hmwhd7", resp.After)
 }
 
+func TestSubredditService_SearchPosts_Flair(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/subreddit/search-posts.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/r/test/search", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		form := url.Values{}
+		form.Set("q", `crash flair:"Bug\report"`)
+		form.Set("restrict_sr", "true")
+		form.Set("category", "abc")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.Form)
+
+		fmt.Fprint(w, blob)
+	})
+
+	_, _, err = client.Subreddit.SearchPosts(ctx, "crash", "test", &ListPostSearchOptions{Flair: `"Bug"`})
+	require.EqualError(t, err, "(ListPostSearchOptions).Flair: cannot contain double quotes")
+
+	_, _, err = client.Subreddit.SearchPosts(ctx, "crash", "test", &ListPostSearchOptions{Category: "abcdef"})
+	require.EqualError(t, err, "(ListPostSearchOptions).Category: cannot be longer than 5 characters")
+
+	posts, _, err := client.Subreddit.SearchPosts(ctx, "crash", "test", &ListPostSearchOptions{
+		Flair:    `Bug\report`,
+		Category: "abc",
+	})
+	require.NoError(t, err)
+	require.Equal(t, expectedSearchPosts, posts)
+}
+
 func TestSubredditService_SearchPosts_InSubreddit(t *testing.T) {
 	client, mux := setup(t)
 
@@ -922,6 +1884,41 @@ func TestSubredditService_SearchPosts_InSubreddit(t *testing.T) {
 	require.Equal(t, "t3_hmwhd7", resp.After)
 }
 
+func TestSubredditService_SearchPosts_SortAndTime(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/subreddit/search-posts.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/r/test/search", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		form := url.Values{}
+		form.Set("q", "test")
+		form.Set("restrict_sr", "true")
+		form.Set("after", "t3_test")
+		form.Set("sort", "new")
+		form.Set("t", "week")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.Form)
+
+		fmt.Fprint(w, blob)
+	})
+
+	posts, resp, err := client.Subreddit.SearchPosts(ctx, "test", "test", &ListPostSearchOptions{
+		ListPostOptions: ListPostOptions{
+			ListOptions: ListOptions{After: "t3_test"},
+			Time:        TimeFilterWeek,
+		},
+		Sort: SearchSortNew,
+	})
+	require.NoError(t, err)
+	require.Equal(t, expectedSearchPosts, posts)
+	require.Equal(t, "t3_hmwhd7", resp.After)
+}
+
 func TestSubredditService_SearchPosts_InSubreddits(t *testing.T) {
 	client, mux := setup(t)
 
@@ -992,6 +1989,28 @@ func TestSubredditService_RandomNSFW(t *testing.T) {
 	require.Equal(t, expectedRandomSubreddit, subreddit)
 }
 
+func TestSubredditService_Sidebar(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/subreddit/about.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/r/golang/about", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, blob)
+	})
+
+	_, _, err = client.Subreddit.Sidebar(ctx, "")
+	require.EqualError(t, err, "name: cannot be empty")
+
+	sidebar, _, err := client.Subreddit.Sidebar(ctx, "golang")
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(sidebar.Markdown, "Please follow the [Go Community Code of Conduct](https://golang.org/conduct) while posting here."))
+	require.True(t, strings.HasPrefix(sidebar.HTML, `<!-- SC_OFF --><div class="md"><p>Please follow the <a href="https://golang.org/conduct">Go Community Code of Conduct</a>`))
+	require.Equal(t, "Ask questions and post articles about the Go programming language and related tools, events etc.", sidebar.PublicDescription)
+	require.Equal(t, "<!-- SC_OFF --><div class=\"md\"><p>Ask questions and post articles about the Go programming language and related tools, events etc.</p>\n</div><!-- SC_ON -->", sidebar.PublicDescriptionHTML)
+}
+
 func TestSubredditService_SubmissionText(t *testing.T) {
 	client, mux := setup(t)
 
@@ -1033,6 +2052,36 @@ func TestSubredditService_Banned(t *testing.T) {
 	require.Equal(t, expectedBans, bans)
 }
 
+func TestSubredditService_BannedUser(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/subreddit/banned-users.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/r/test/about/banned", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+
+		if r.Form.Get("user") == "testuser3" {
+			fmt.Fprint(w, `{"kind": "UserList", "data": {"children": []}}`)
+			return
+		}
+
+		require.Equal(t, "testuser2", r.Form.Get("user"))
+		fmt.Fprint(w, blob)
+	})
+
+	ban, _, err := client.Subreddit.BannedUser(ctx, "test", "testuser2")
+	require.NoError(t, err)
+	require.Equal(t, expectedBans[1], ban)
+
+	ban, _, err = client.Subreddit.BannedUser(ctx, "test", "testuser3")
+	require.NoError(t, err)
+	require.Nil(t, ban)
+}
+
 func TestSubredditService_Muted(t *testing.T) {
 	client, mux := setup(t)
 
@@ -1163,6 +2212,25 @@ func TestSubredditService_Rules(t *testing.T) {
 	require.Equal(t, expectedRules, rules)
 }
 
+func TestSubredditService_ReportReasons(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/subreddit/rules.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/r/testsubreddit/about/rules", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, blob)
+	})
+
+	reasons, _, err := client.Subreddit.ReportReasons(ctx, "testsubreddit")
+	require.NoError(t, err)
+	require.Equal(t, &ReportReasons{
+		RuleReasons: []string{"Read the Rules Before Posting", "Read the Wiki Before Posting"},
+		SiteReasons: []string{"Spam", "Personal and confidential information", "Threatening, harassing, or inciting violence"},
+	}, reasons)
+}
+
 func TestSubredditService_CreateRule(t *testing.T) {
 	client, mux := setup(t)
 
@@ -1542,6 +2610,124 @@ func TestSubredditService_UploadMobileIcon(t *testing.T) {
 	require.Equal(t, "https://example.com/test.jpg", link)
 }
 
+func TestSubredditService_UploadIcon(t *testing.T) {
+	client, mux := setup(t)
+
+	uploadURL := client.BaseURL.Host + "/api/style_upload"
+
+	imageFile, err := ioutil.TempFile("/tmp", "icon*.png")
+	require.NoError(t, err)
+	defer func() {
+		imageFile.Close()
+		os.Remove(imageFile.Name())
+	}()
+
+	_, err = imageFile.WriteString("this is a test")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/api/v1/style_asset_upload_s3/testsubreddit", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("imagetype", "communityIcon")
+		form.Set("filepath", filepath.Base(imageFile.Name()))
+		form.Set("mimetype", "image/png")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+
+		fmt.Fprintf(w, `{
+			"s3UploadLease": {
+				"action": "//%s",
+				"fields": [{"name": "key", "value": "testsubreddit/icon.png"}]
+			}
+		}`, uploadURL)
+	})
+
+	mux.HandleFunc("/api/style_upload", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "testsubreddit/icon.png", r.FormValue("key"))
+	})
+
+	mux.HandleFunc("/api/v1/structured_styles/testsubreddit", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPatch, r.Method)
+
+		form := url.Values{}
+		form.Set("communityIcon", "http://"+uploadURL+"/testsubreddit/icon.png")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+	})
+
+	_, _, err = client.Subreddit.UploadIcon(ctx, "testsubreddit", "icon.txt")
+	require.EqualError(t, err, `unsupported image file type: ".txt"`)
+
+	link, _, err := client.Subreddit.UploadIcon(ctx, "testsubreddit", imageFile.Name())
+	require.NoError(t, err)
+	require.Equal(t, "http://"+uploadURL+"/testsubreddit/icon.png", link)
+}
+
+func TestSubredditService_UploadBanner(t *testing.T) {
+	client, mux := setup(t)
+
+	uploadURL := client.BaseURL.Host + "/api/style_upload"
+
+	imageFile, err := ioutil.TempFile("/tmp", "banner*.jpg")
+	require.NoError(t, err)
+	defer func() {
+		imageFile.Close()
+		os.Remove(imageFile.Name())
+	}()
+
+	_, err = imageFile.WriteString("this is a test")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/api/v1/style_asset_upload_s3/testsubreddit", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("imagetype", "bannerBackgroundImage")
+		form.Set("filepath", filepath.Base(imageFile.Name()))
+		form.Set("mimetype", "image/jpeg")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+
+		fmt.Fprintf(w, `{
+			"s3UploadLease": {
+				"action": "//%s",
+				"fields": [{"name": "key", "value": "testsubreddit/banner.jpg"}]
+			}
+		}`, uploadURL)
+	})
+
+	mux.HandleFunc("/api/style_upload", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "testsubreddit/banner.jpg", r.FormValue("key"))
+	})
+
+	mux.HandleFunc("/api/v1/structured_styles/testsubreddit", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPatch, r.Method)
+
+		form := url.Values{}
+		form.Set("bannerBackgroundImage", "http://"+uploadURL+"/testsubreddit/banner.jpg")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+	})
+
+	_, _, err = client.Subreddit.UploadBanner(ctx, "testsubreddit", "banner.mp4")
+	require.EqualError(t, err, `unsupported image file type: ".mp4"`)
+
+	link, _, err := client.Subreddit.UploadBanner(ctx, "testsubreddit", imageFile.Name())
+	require.NoError(t, err)
+	require.Equal(t, "http://"+uploadURL+"/testsubreddit/banner.jpg", link)
+}
+
 func TestSubredditService_UploadImage_Error(t *testing.T) {
 	client, mux := setup(t)
 
@@ -1595,6 +2781,8 @@ func TestSubredditService_Create(t *testing.T) {
 		form.Set("allow_galleries", "true")
 		form.Set("exclude_banned_modqueue", "false")
 		form.Set("crowd_control_chat_level", "2")
+		form.Set("crowd_control_mode", "false")
+		form.Set("crowd_control_level", "0")
 		form.Set("all_original_content", "false")
 		form.Set("submit_link_label", "submit a link!")
 		form.Set("submit_text_label", "submit a post!")
@@ -1655,6 +2843,8 @@ func TestSubredditService_Edit(t *testing.T) {
 		form.Set("allow_galleries", "true")
 		form.Set("exclude_banned_modqueue", "false")
 		form.Set("crowd_control_chat_level", "2")
+		form.Set("crowd_control_mode", "false")
+		form.Set("crowd_control_level", "0")
 		form.Set("all_original_content", "false")
 		form.Set("submit_link_label", "submit a link!")
 		form.Set("submit_text_label", "submit a post!")
@@ -1689,6 +2879,40 @@ func TestSubredditService_Edit(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestSubredditService_UpdateSettings(t *testing.T) {
+	client, mux := setup(t)
+
+	blob, err := readFileContents("../testdata/subreddit/settings.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/r/test/about/edit", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, blob)
+	})
+
+	mux.HandleFunc("/api/site_admin", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, "t5_test", r.PostForm.Get("sr"))
+		require.Equal(t, "new title", r.PostForm.Get("title"))
+		require.Equal(t, "high", r.PostForm.Get("spam_links"))
+		require.Equal(t, "sidebar", r.PostForm.Get("description"))
+		require.Equal(t, "modonly", r.PostForm.Get("wikimode"))
+		require.Equal(t, "low", r.PostForm.Get("spam_comments"))
+	})
+
+	_, err = client.Subreddit.UpdateSettings(ctx, "test", nil)
+	require.EqualError(t, err, "*SubredditSettings: cannot be nil")
+
+	_, err = client.Subreddit.UpdateSettings(ctx, "test", &SubredditSettings{
+		Title:                       String("new title"),
+		SpamFilterStrengthLinkPosts: String("high"),
+	})
+	require.NoError(t, err)
+}
+
 func TestSubredditService_GetSettings(t *testing.T) {
 	client, mux := setup(t)
 
@@ -3,6 +3,7 @@ package reddit
 import (
 	"encoding/json"
 	"fmt"
+	"html"
 )
 
 const (
@@ -389,21 +390,120 @@ func (l *trophyList) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+// UserReport is a report made by users on a post or comment.
+type UserReport struct {
+	Reason string
+	// The number of users who reported it for this reason.
+	Count     int
+	Snoozed   bool
+	CanSnooze bool
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// Reddit returns a report as an array of its reason, count and snooze states.
+func (r *UserReport) UnmarshalJSON(data []byte) error {
+	var info []interface{}
+	err := json.Unmarshal(data, &info)
+	if err != nil {
+		return err
+	}
+
+	if len(info) > 0 {
+		r.Reason, _ = info[0].(string)
+	}
+	if len(info) > 1 {
+		count, _ := info[1].(float64)
+		r.Count = int(count)
+	}
+	if len(info) > 2 {
+		r.Snoozed, _ = info[2].(bool)
+	}
+	if len(info) > 3 {
+		r.CanSnooze, _ = info[3].(bool)
+	}
+
+	return nil
+}
+
+// ModReport is a report made by a moderator on a post or comment.
+type ModReport struct {
+	Reason    string
+	Moderator string
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// Reddit returns a report as an array of its reason and the moderator's username.
+func (r *ModReport) UnmarshalJSON(data []byte) error {
+	var info []interface{}
+	err := json.Unmarshal(data, &info)
+	if err != nil {
+		return err
+	}
+
+	if len(info) > 0 {
+		r.Reason, _ = info[0].(string)
+	}
+	if len(info) > 1 {
+		r.Moderator, _ = info[1].(string)
+	}
+
+	return nil
+}
+
+// UserReports is a list of user reports.
+type UserReports []*UserReport
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// An empty list is decoded as nil.
+func (r *UserReports) UnmarshalJSON(data []byte) error {
+	var reports []*UserReport
+	err := json.Unmarshal(data, &reports)
+	if err != nil {
+		return err
+	}
+
+	if len(reports) == 0 {
+		reports = nil
+	}
+	*r = reports
+	return nil
+}
+
+// ModReports is a list of moderator reports.
+type ModReports []*ModReport
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// An empty list is decoded as nil.
+func (r *ModReports) UnmarshalJSON(data []byte) error {
+	var reports []*ModReport
+	err := json.Unmarshal(data, &reports)
+	if err != nil {
+		return err
+	}
+
+	if len(reports) == 0 {
+		reports = nil
+	}
+	*r = reports
+	return nil
+}
+
 // Comment is a comment posted by a user.
 type Comment struct {
 	ID      string     `json:"id,omitempty"`
 	FullID  string     `json:"name,omitempty"`
 	Created *Timestamp `json:"created_utc,omitempty"`
-	Edited  *Timestamp `json:"edited,omitempty"`
+	Edited  *Edited    `json:"edited,omitempty"`
 
 	ParentID  string `json:"parent_id,omitempty"`
 	Permalink string `json:"permalink,omitempty"`
 
-	Body            string `json:"body,omitempty"`
-	Author          string `json:"author,omitempty"`
-	AuthorID        string `json:"author_fullname,omitempty"`
-	AuthorFlairText string `json:"author_flair_text,omitempty"`
-	AuthorFlairID   string `json:"author_flair_template_id,omitempty"`
+	Body                string        `json:"body,omitempty"`
+	Author              string        `json:"author,omitempty"`
+	AuthorID            string        `json:"author_fullname,omitempty"`
+	AuthorFlairText     string        `json:"author_flair_text,omitempty"`
+	AuthorFlairID       string        `json:"author_flair_template_id,omitempty"`
+	AuthorFlairRichText FlairRichText `json:"author_flair_richtext,omitempty"`
 
 	SubredditName         string `json:"subreddit,omitempty"`
 	SubredditNamePrefixed string `json:"subreddit_name_prefixed,omitempty"`
@@ -427,6 +527,8 @@ type Comment struct {
 	PostNumComments *int `json:"num_comments,omitempty"`
 
 	IsSubmitter bool `json:"is_submitter"`
+	// The score is hidden when the post is in contest mode,
+	// or when the comment is too recent.
 	ScoreHidden bool `json:"score_hidden"`
 	Saved       bool `json:"saved"`
 	Stickied    bool `json:"stickied"`
@@ -434,9 +536,25 @@ type Comment struct {
 	CanGild     bool `json:"can_gild"`
 	NSFW        bool `json:"over_18"`
 
+	Collapsed bool `json:"collapsed"`
+	// Why the comment is collapsed, e.g. because its score is below the threshold.
+	CollapsedReason string `json:"collapsed_reason,omitempty"`
+	// Whether the comment is collapsed because of the crowd control level of the post or subreddit.
+	CollapsedByCrowdControl bool `json:"collapsed_because_crowd_control"`
+
+	// The reports are only visible to the moderators of the subreddit.
+	NumReports  int         `json:"num_reports"`
+	UserReports UserReports `json:"user_reports,omitempty"`
+	ModReports  ModReports  `json:"mod_reports,omitempty"`
+
 	Replies Replies `json:"replies"`
 }
 
+// Vote returns your vote on the comment.
+func (c *Comment) Vote() Vote {
+	return voteFromLikes(c.Likes)
+}
+
 // HasMore determines whether the comment has more replies to load in its reply tree.
 func (c *Comment) HasMore() bool {
 	return c.Replies.More != nil && len(c.Replies.More.Children) > 0
@@ -466,6 +584,17 @@ func (c *Comment) addMoreToReplies(more *More) {
 	}
 }
 
+func (c *Comment) removeMoreFromReplies(more *More) {
+	if c.Replies.More == more {
+		c.Replies.More = nil
+		return
+	}
+
+	for _, reply := range c.Replies.Comments {
+		reply.removeMoreFromReplies(more)
+	}
+}
+
 // Replies holds replies to a comment.
 // It contains both comments and "more" comments, which are entrypoints to other
 // comments that were left out.
@@ -523,7 +652,7 @@ type Post struct {
 	ID      string     `json:"id,omitempty"`
 	FullID  string     `json:"name,omitempty"`
 	Created *Timestamp `json:"created_utc,omitempty"`
-	Edited  *Timestamp `json:"edited,omitempty"`
+	Edited  *Edited    `json:"edited,omitempty"`
 	IsVideo bool       `json:"is_video,omitempty"`
 
 	Permalink string `json:"permalink,omitempty"`
@@ -545,8 +674,9 @@ type Post struct {
 	SubredditID           string `json:"subreddit_id,omitempty"`
 	SubredditSubscribers  int    `json:"subreddit_subscribers"`
 
-	Author   string `json:"author,omitempty"`
-	AuthorID string `json:"author_fullname,omitempty"`
+	Author              string        `json:"author,omitempty"`
+	AuthorID            string        `json:"author_fullname,omitempty"`
+	AuthorFlairRichText FlairRichText `json:"author_flair_richtext,omitempty"`
 
 	Spoiler    bool `json:"spoiler"`
 	Locked     bool `json:"locked"`
@@ -554,6 +684,220 @@ type Post struct {
 	IsSelfPost bool `json:"is_self"`
 	Saved      bool `json:"saved"`
 	Stickied   bool `json:"stickied"`
+	// In contest mode, comments are sorted randomly and their scores are hidden.
+	ContestMode bool `json:"contest_mode"`
+
+	// Only set for event posts.
+	EventStart  *Timestamp `json:"event_start,omitempty"`
+	EventEnd    *Timestamp `json:"event_end,omitempty"`
+	EventIsLive bool       `json:"event_is_live,omitempty"`
+
+	LinkFlairID       string        `json:"link_flair_template_id,omitempty"`
+	LinkFlairText     string        `json:"link_flair_text,omitempty"`
+	LinkFlairCSSClass string        `json:"link_flair_css_class,omitempty"`
+	LinkFlairRichText FlairRichText `json:"link_flair_richtext,omitempty"`
+
+	// The reports are only visible to the moderators of the subreddit.
+	NumReports  int         `json:"num_reports"`
+	UserReports UserReports `json:"user_reports,omitempty"`
+	ModReports  ModReports  `json:"mod_reports,omitempty"`
+
+	Preview     *PostPreview `json:"preview,omitempty"`
+	Media       *PostMedia   `json:"media,omitempty"`
+	SecureMedia *PostMedia   `json:"secure_media,omitempty"`
+
+	// Only set for gallery posts. The items of the gallery are ordered by
+	// GalleryData, and the media of each item is found in MediaMetadata by its ID.
+	IsGallery     bool                      `json:"is_gallery,omitempty"`
+	GalleryData   *GalleryData              `json:"gallery_data,omitempty"`
+	MediaMetadata map[string]*MediaMetadata `json:"media_metadata,omitempty"`
+
+	// Only set for poll posts.
+	PollData *PollData `json:"poll_data,omitempty"`
+
+	AllAwardings []*Award `json:"all_awardings,omitempty"`
+}
+
+// PostPreview holds the preview images of a post.
+type PostPreview struct {
+	Images  []*PostPreviewImage `json:"images,omitempty"`
+	Enabled bool                `json:"enabled"`
+}
+
+// PostPreviewImage is a preview image of a post, available in different resolutions.
+type PostPreviewImage struct {
+	ID          string         `json:"id,omitempty"`
+	Source      *ImageSource   `json:"source,omitempty"`
+	Resolutions []*ImageSource `json:"resolutions,omitempty"`
+}
+
+// ImageSource is the URL and dimensions of an image.
+type ImageSource struct {
+	URL    string `json:"url,omitempty"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+}
+
+// PostMedia is the media embedded in a post, either hosted by Reddit (RedditVideo) or by a third party (OEmbed).
+type PostMedia struct {
+	Type        string       `json:"type,omitempty"`
+	RedditVideo *RedditVideo `json:"reddit_video,omitempty"`
+	OEmbed      *OEmbed      `json:"oembed,omitempty"`
+}
+
+// RedditVideo is a video hosted by Reddit, i.e. on v.redd.it.
+type RedditVideo struct {
+	FallbackURL       string `json:"fallback_url,omitempty"`
+	DASHURL           string `json:"dash_url,omitempty"`
+	HLSURL            string `json:"hls_url,omitempty"`
+	ScrubberMediaURL  string `json:"scrubber_media_url,omitempty"`
+	TranscodingStatus string `json:"transcoding_status,omitempty"`
+
+	Width    int  `json:"width"`
+	Height   int  `json:"height"`
+	Duration int  `json:"duration"`
+	Bitrate  int  `json:"bitrate_kbps"`
+	IsGIF    bool `json:"is_gif"`
+}
+
+// OEmbed is media embedded from a third party website, e.g. YouTube.
+type OEmbed struct {
+	Type         string `json:"type,omitempty"`
+	Title        string `json:"title,omitempty"`
+	HTML         string `json:"html,omitempty"`
+	ProviderName string `json:"provider_name,omitempty"`
+	ProviderURL  string `json:"provider_url,omitempty"`
+	AuthorName   string `json:"author_name,omitempty"`
+	AuthorURL    string `json:"author_url,omitempty"`
+	ThumbnailURL string `json:"thumbnail_url,omitempty"`
+	Width        int    `json:"width"`
+	Height       int    `json:"height"`
+}
+
+// GalleryData holds the ordered items of a gallery post.
+type GalleryData struct {
+	Items []*GalleryItem `json:"items,omitempty"`
+}
+
+// GalleryItem is an item of a gallery post.
+type GalleryItem struct {
+	ID          int    `json:"id"`
+	MediaID     string `json:"media_id,omitempty"`
+	Caption     string `json:"caption,omitempty"`
+	OutboundURL string `json:"outbound_url,omitempty"`
+}
+
+// MediaMetadata is a media file uploaded to Reddit, e.g. an image of a gallery post.
+type MediaMetadata struct {
+	ID     string `json:"id,omitempty"`
+	Status string `json:"status,omitempty"`
+	// One of: Image, AnimatedImage.
+	Kind     string `json:"e,omitempty"`
+	MimeType string `json:"m,omitempty"`
+
+	Source   *MediaSource   `json:"s,omitempty"`
+	Previews []*MediaSource `json:"p,omitempty"`
+}
+
+// MediaSource is the URL and dimensions of a media file.
+// For animated images, the GIF and/or MP4 URLs are set instead of URL.
+type MediaSource struct {
+	URL    string `json:"u,omitempty"`
+	GIF    string `json:"gif,omitempty"`
+	MP4    string `json:"mp4,omitempty"`
+	Width  int    `json:"x"`
+	Height int    `json:"y"`
+}
+
+// Vote returns your vote on the post.
+func (p *Post) Vote() Vote {
+	return voteFromLikes(p.Likes)
+}
+
+// GalleryImage is an image of a gallery post.
+type GalleryImage struct {
+	MediaID     string `json:"media_id,omitempty"`
+	Caption     string `json:"caption,omitempty"`
+	OutboundURL string `json:"outbound_url,omitempty"`
+
+	URL    string `json:"url,omitempty"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+}
+
+// GalleryImages returns the images of a gallery post, in the order they appear in the gallery.
+// For each image, the smallest resolution that is at least width pixels wide is picked.
+// If width is 0, or if no resolution is wide enough, the source image is used.
+func (p *Post) GalleryImages(width int) []*GalleryImage {
+	if p.GalleryData == nil {
+		return nil
+	}
+
+	var images []*GalleryImage
+	for _, item := range p.GalleryData.Items {
+		metadata, ok := p.MediaMetadata[item.MediaID]
+		if !ok || metadata.Source == nil {
+			continue
+		}
+
+		source := metadata.Source
+		if width > 0 {
+			for _, preview := range metadata.Previews {
+				if preview.Width >= width && (source == metadata.Source || preview.Width < source.Width) {
+					source = preview
+				}
+			}
+		}
+
+		u := source.URL
+		if u == "" {
+			u = source.GIF
+		}
+		if u == "" {
+			u = source.MP4
+		}
+
+		images = append(images, &GalleryImage{
+			MediaID:     item.MediaID,
+			Caption:     item.Caption,
+			OutboundURL: item.OutboundURL,
+			// URLs are HTML-escaped in Reddit's JSON responses
+			URL:    html.UnescapeString(u),
+			Width:  source.Width,
+			Height: source.Height,
+		})
+	}
+
+	return images
+}
+
+// PollData holds the options and results of a poll post.
+type PollData struct {
+	// Milliseconds since the Unix epoch.
+	VotingEndTimestamp int64         `json:"voting_end_timestamp"`
+	TotalVoteCount     int           `json:"total_vote_count"`
+	UserSelection      string        `json:"user_selection,omitempty"`
+	Options            []*PollOption `json:"options,omitempty"`
+}
+
+// PollOption is an option of a poll.
+type PollOption struct {
+	ID   string `json:"id,omitempty"`
+	Text string `json:"text,omitempty"`
+	// Only visible once the poll is over, or once you've voted.
+	VoteCount *int `json:"vote_count,omitempty"`
+}
+
+// Award is an award given to a post or comment.
+type Award struct {
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	IconURL     string `json:"icon_url,omitempty"`
+	// One of: global, community.
+	Type      string `json:"award_type,omitempty"`
+	CoinPrice int    `json:"coin_price"`
+	Count     int    `json:"count"`
 }
 
 // Subreddit holds information about a subreddit
@@ -570,9 +914,39 @@ type Subreddit struct {
 	Type                 string `json:"subreddit_type,omitempty"`
 	SuggestedCommentSort string `json:"suggested_comment_sort,omitempty"`
 
+	IconURL          string `json:"icon_img,omitempty"`
+	CommunityIconURL string `json:"community_icon,omitempty"`
+	HeaderURL        string `json:"header_img,omitempty"`
+	BannerURL        string `json:"banner_background_image,omitempty"`
+	PrimaryColor     string `json:"primary_color,omitempty"`
+	KeyColor         string `json:"key_color,omitempty"`
+	Language         string `json:"lang,omitempty"`
+
+	// One of: any, link, self.
+	SubmissionType  string `json:"submission_type,omitempty"`
+	AllowImages     bool   `json:"allow_images"`
+	AllowVideos     bool   `json:"allow_videos"`
+	AllowGalleries  bool   `json:"allow_galleries"`
+	AllowPolls      bool   `json:"allow_polls"`
+	SpoilersEnabled bool   `json:"spoilers_enabled"`
+
+	AllowVideoGIFs            bool `json:"allow_videogifs"`
+	AllowChatPosts            bool `json:"allow_chat_post_creation"`
+	RestrictPosting           bool `json:"restrict_posting"`
+	RestrictCommenting        bool `json:"restrict_commenting"`
+	Crosspostable             bool `json:"is_crosspostable_subreddit"`
+	WikiEnabled               bool `json:"wiki_enabled"`
+	EmojisEnabled             bool `json:"emojis_enabled"`
+	OriginalContentTagEnabled bool `json:"original_content_tag_enabled"`
+	LinkFlairEnabled          bool `json:"link_flair_enabled"`
+	UserFlairEnabled          bool `json:"user_flair_enabled_in_sr"`
+	// Reddit only reports this to the moderators of the subreddit.
+	NewModmailEnabled bool `json:"is_enrolled_in_new_modmail"`
+
 	Subscribers     int  `json:"subscribers"`
 	ActiveUserCount *int `json:"active_user_count,omitempty"`
 	NSFW            bool `json:"over18"`
+	Quarantined     bool `json:"quarantine"`
 	UserIsMod       bool `json:"user_is_moderator"`
 	Subscribed      bool `json:"user_is_subscriber"`
 	Favorite        bool `json:"user_has_favorited"`
@@ -634,3 +1008,52 @@ func (pc *PostAndComments) addMoreToTree(more *More) {
 		reply.addMoreToReplies(more)
 	}
 }
+
+func (pc *PostAndComments) removeMoreFromTree(more *More) {
+	if pc.More == more {
+		pc.More = nil
+		return
+	}
+
+	for _, reply := range pc.Comments {
+		reply.removeMoreFromReplies(more)
+	}
+}
+
+// moreNodes returns the "more" nodes of the comment tree that have comments to load.
+// The nodes deeper than maxDepth are left out, unless maxDepth is 0.
+func (pc *PostAndComments) moreNodes(maxDepth int) []*More {
+	var mores []*More
+	if pc.HasMore() {
+		mores = append(mores, pc.More)
+	}
+
+	var walk func(comments []*Comment, depth int)
+	walk = func(comments []*Comment, depth int) {
+		if maxDepth > 0 && depth > maxDepth {
+			return
+		}
+		for _, c := range comments {
+			if c.HasMore() && (maxDepth == 0 || depth+1 <= maxDepth) {
+				mores = append(mores, c.Replies.More)
+			}
+			walk(c.Replies.Comments, depth+1)
+		}
+	}
+	walk(pc.Comments, 1)
+
+	return mores
+}
+
+// numComments returns the number of comments in the comment tree.
+func (pc *PostAndComments) numComments() int {
+	var count func(comments []*Comment) int
+	count = func(comments []*Comment) int {
+		n := len(comments)
+		for _, c := range comments {
+			n += count(c.Replies.Comments)
+		}
+		return n
+	}
+	return count(pc.Comments)
+}
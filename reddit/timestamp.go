@@ -45,3 +45,60 @@ func (t *Timestamp) UnmarshalJSON(data []byte) (err error) {
 func (t Timestamp) Equal(u Timestamp) bool {
 	return t.Time.Equal(u.Time)
 }
+
+// Edited represents when a post or comment was edited.
+// Reddit returns either false, if it was never edited, or the time of its last edit.
+type Edited struct {
+	edited bool
+	at     time.Time
+}
+
+// NewEdited returns an Edited value for a post or comment that was edited at the given time.
+// If the time is unknown, the zero time can be used. A post or comment that was never
+// edited is represented by the zero value, i.e. &Edited{}.
+func NewEdited(t time.Time) *Edited {
+	return &Edited{edited: true, at: t}
+}
+
+// Bool reports whether the post or comment was edited.
+func (e *Edited) Bool() bool {
+	return e != nil && e.edited
+}
+
+// Time returns the time of the last edit.
+// It is the zero time if the post or comment was never edited, or if the time is unknown.
+func (e *Edited) Time() time.Time {
+	if e == nil {
+		return time.Time{}
+	}
+	return e.at
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (e *Edited) MarshalJSON() ([]byte, error) {
+	if !e.Bool() {
+		return []byte(`false`), nil
+	}
+	if e.at.IsZero() {
+		return []byte(`true`), nil
+	}
+	return []byte(strconv.FormatInt(e.at.Unix(), 10)), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// The edited field is expected to be either a boolean or a Unix timestamp.
+func (e *Edited) UnmarshalJSON(data []byte) error {
+	switch str := string(data); str {
+	case "false", "null":
+		*e = Edited{}
+	case "true":
+		*e = Edited{edited: true}
+	default:
+		f, err := strconv.ParseFloat(str, 64)
+		if err != nil {
+			return err
+		}
+		*e = Edited{edited: true, at: time.Unix(int64(f), 0).UTC()}
+	}
+	return nil
+}
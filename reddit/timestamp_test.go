@@ -180,3 +180,51 @@ func TestWrappedTimstamp_MarshalReflexivity(t *testing.T) {
 		}
 	}
 }
+
+func TestEdited_Unmarshal(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		data     string
+		wantBool bool
+		wantTime time.Time
+		wantErr  bool
+	}{
+		{"NotEdited", `false`, false, time.Time{}, false},
+		{"EditedUnknownTime", `true`, true, time.Time{}, false},
+		{"EditedUnix", referenceUnixTimeStr, true, referenceTime, false},
+		{"EditedUnixFloat", referenceUnixTimeStr + ".0", true, referenceTime, false},
+		{"Invalid", `"asdf"`, false, time.Time{}, true},
+	}
+	for _, tc := range testCases {
+		var got Edited
+		err := json.Unmarshal([]byte(tc.data), &got)
+		if gotErr := err != nil; gotErr != tc.wantErr {
+			t.Fatalf("%s: gotErr=%v, wantErr=%v, err=%v", tc.desc, gotErr, tc.wantErr, err)
+		}
+		if got.Bool() != tc.wantBool || !got.Time().Equal(tc.wantTime) {
+			t.Fatalf("%s: got=(%v, %v), want=(%v, %v)", tc.desc, got.Bool(), got.Time(), tc.wantBool, tc.wantTime)
+		}
+	}
+}
+
+func TestEdited_MarshalReflexivity(t *testing.T) {
+	testCases := []*Edited{
+		{},
+		NewEdited(time.Time{}),
+		NewEdited(referenceTime),
+	}
+	for _, want := range testCases {
+		data, err := json.Marshal(want)
+		if err != nil {
+			t.Fatalf("%#v: unexpected error: %v", want, err)
+		}
+
+		got := new(Edited)
+		if err := json.Unmarshal(data, got); err != nil {
+			t.Fatalf("%s: unexpected error: %v", data, err)
+		}
+		if *got != *want {
+			t.Fatalf("%s: got=%#v, want=%#v", data, got, want)
+		}
+	}
+}
@@ -12,7 +12,8 @@ import (
 //
 // Reddit API docs: https://www.reddit.com/dev/api/#section_users
 type UserService struct {
-	client *Client
+	client  *Client
+	batcher *batcher
 }
 
 // User represents a Reddit user.
@@ -71,7 +72,41 @@ func (s *UserService) Get(ctx context.Context, username string) (*User, *Respons
 
 // GetMultipleByID returns multiple users from their full IDs.
 // The response body is a map where the keys are the IDs (if they exist), and the value is the user.
+// If the client was configured with the WithBatchWindow option, lookups made within the window
+// are combined into a single request.
 func (s *UserService) GetMultipleByID(ctx context.Context, ids ...string) (map[string]*UserSummary, *Response, error) {
+	if s.batcher == nil {
+		return s.getMultipleByID(ctx, ids...)
+	}
+
+	values, resp, err := s.batcher.Lookup(ctx, ids)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	users := make(map[string]*UserSummary, len(values))
+	for id, v := range values {
+		users[id] = v.(*UserSummary)
+	}
+
+	return users, resp, nil
+}
+
+func (s *UserService) getMultipleByIDBatch(ctx context.Context, ids []string) (map[string]interface{}, *Response, error) {
+	users, resp, err := s.getMultipleByID(ctx, ids...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	values := make(map[string]interface{}, len(users))
+	for id, user := range users {
+		values[id] = user
+	}
+
+	return values, resp, nil
+}
+
+func (s *UserService) getMultipleByID(ctx context.Context, ids ...string) (map[string]*UserSummary, *Response, error) {
 	params := struct {
 		IDs []string `url:"ids,omitempty,comma"`
 	}{ids}
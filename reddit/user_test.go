@@ -50,7 +50,7 @@ var expectedPost = &Post{
 	ID:      "gczwql",
 	FullID:  "t3_gczwql",
 	Created: &Timestamp{time.Date(2020, 5, 3, 22, 46, 25, 0, time.UTC)},
-	Edited:  &Timestamp{time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)},
+	Edited:  &Edited{},
 
 	Permalink: "/r/redditdev/comments/gczwql/get_userusernamegilded_does_it_return_other_users/",
 	URL:       "https://www.reddit.com/r/redditdev/comments/gczwql/get_userusernamegilded_does_it_return_other_users/",
@@ -73,13 +73,17 @@ var expectedPost = &Post{
 	AuthorID: "t2_164ab8",
 
 	IsSelfPost: true,
+
+	LinkFlairID:   "c4edd5ce-40e8-11e7-b814-0ef91bd65558",
+	LinkFlairText: "Reddit API",
+	AllAwardings:  []*Award{},
 }
 
 var expectedComment = &Comment{
 	ID:      "f0zsa37",
 	FullID:  "t1_f0zsa37",
 	Created: &Timestamp{time.Date(2019, 9, 21, 21, 38, 16, 0, time.UTC)},
-	Edited:  &Timestamp{time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)},
+	Edited:  &Edited{},
 
 	ParentID:  "t3_d7ejpn",
 	Permalink: "/r/apple/comments/d7ejpn/im_giving_away_an_iphone_11_pro_to_a_commenter_at/f0zsa37/",
@@ -142,6 +146,19 @@ var expectedUserSubreddits = []*Subreddit{
 		Title:        "nickofnight",
 		Description:  "Stories written for Writing Prompts, NoSleep, and originals. Current series: The Carnival of Night ",
 		Type:         "user",
+
+		IconURL:  "https://styles.redditmedia.com/t5_3kefx/styles/profileIcon_w1vytyimts541.png?width=256&amp;height=256&amp;crop=256:256,smart&amp;s=e722798c6253d3ae3990bf42c3ae844d7c2a924b",
+		KeyColor: "#222222",
+		Language: "en",
+
+		SubmissionType:  "any",
+		AllowImages:     true,
+		AllowVideos:     true,
+		AllowGalleries:  true,
+		AllowPolls:      true,
+		SpoilersEnabled: true,
+		AllowVideoGIFs:  true,
+		RestrictPosting: true,
 	},
 	{
 		ID:      "3knn1",
@@ -155,6 +172,18 @@ var expectedUserSubreddits = []*Subreddit{
 		Description:          "In nineteen ninety eight the undertaker threw mankind off hеll in a cell, and plummeted sixteen feet through an announcer's table.",
 		Type:                 "user",
 		SuggestedCommentSort: "qa",
+
+		IconURL:  "https://styles.redditmedia.com/t5_3knn1/styles/profileIcon_b51xzp4vbvs41.jpg?width=256&amp;height=256&amp;crop=256:256,smart&amp;s=6535d6f05d037d43d72217899d3f81aba4fb442d",
+		Language: "en",
+
+		SubmissionType:  "any",
+		AllowImages:     true,
+		AllowVideos:     true,
+		AllowGalleries:  true,
+		AllowPolls:      true,
+		SpoilersEnabled: true,
+		AllowVideoGIFs:  true,
+		RestrictPosting: true,
 	},
 }
 
@@ -37,6 +37,7 @@ const (
 	widgetKindModerators       = "moderators"
 	widgetKindSubredditRules   = "subreddit-rules"
 	widgetKindCustom           = "custom"
+	widgetKindCalendar         = "calendar"
 )
 
 type rootWidget struct {
@@ -73,6 +74,8 @@ func (w *rootWidget) UnmarshalJSON(data []byte) error {
 		w.Data = new(SubredditRulesWidget)
 	case widgetKindCustom:
 		w.Data = new(CustomWidget)
+	case widgetKindCalendar:
+		w.Data = new(CalendarWidget)
 	default:
 		return fmt.Errorf("unrecognized widget kind: %q", root.Kind)
 	}
@@ -254,6 +257,40 @@ type CustomWidget struct {
 	StyleSheet    string         `json:"css,omitempty"`
 	StyleSheetURL string         `json:"stylesheetUrl,omitempty"`
 	Images        []*WidgetImage `json:"imageData,omitempty"`
+	// Height of the widget, in pixels.
+	Height int `json:"height,omitempty"`
+}
+
+// CalendarWidget displays upcoming events from a Google Calendar.
+type CalendarWidget struct {
+	widget
+
+	Name             string                       `json:"shortName,omitempty"`
+	GoogleCalendarID string                       `json:"googleCalendarId,omitempty"`
+	RequiresSync     bool                         `json:"requiresSync"`
+	Configuration    *CalendarWidgetConfiguration `json:"configuration,omitempty"`
+	Events           []*CalendarWidgetEvent       `json:"data,omitempty"`
+}
+
+// CalendarWidgetConfiguration is the configuration of what a calendar widget displays.
+type CalendarWidgetConfiguration struct {
+	// Between 1 and 50.
+	NumberOfEvents  int  `json:"numEvents"`
+	ShowDate        bool `json:"showDate"`
+	ShowDescription bool `json:"showDescription"`
+	ShowLocation    bool `json:"showLocation"`
+	ShowTime        bool `json:"showTime"`
+	ShowTitle       bool `json:"showTitle"`
+}
+
+// CalendarWidgetEvent is an event displayed in a calendar widget.
+type CalendarWidgetEvent struct {
+	Title       string     `json:"title,omitempty"`
+	Description string     `json:"description,omitempty"`
+	Location    string     `json:"location,omitempty"`
+	Start       *Timestamp `json:"startTime,omitempty"`
+	End         *Timestamp `json:"endTime,omitempty"`
+	AllDay      bool       `json:"allDay"`
 }
 
 // WidgetStyle contains style information for the widget.
@@ -264,8 +301,10 @@ type WidgetStyle struct {
 
 // WidgetImage is an image in a widget.
 type WidgetImage struct {
-	Name string `json:"name"`
-	URL  string `json:"url"`
+	Name   string `json:"name"`
+	URL    string `json:"url"`
+	Width  int    `json:"width,omitempty"`
+	Height int    `json:"height,omitempty"`
 }
 
 // WidgetLink is a link or a group of links that's part of a widget.
@@ -331,7 +370,9 @@ func (l *WidgetLinkList) UnmarshalJSON(data []byte) error {
 // WidgetImageLink is an image that links to an URL within a widget.
 type WidgetImageLink struct {
 	URL     string `json:"url,omitempty"`
-	LinkURL string `json:"linkURL,omitempty"`
+	LinkURL string `json:"linkUrl,omitempty"`
+	Width   int    `json:"width,omitempty"`
+	Height  int    `json:"height,omitempty"`
 }
 
 // WidgetCommunity is a community (subreddit) that's displayed in a widget.
@@ -344,8 +385,15 @@ type WidgetCommunity struct {
 
 // WidgetButton is a button that's part of a widget.
 type WidgetButton struct {
-	Text      string `json:"text,omitempty"`
-	URL       string `json:"url,omitempty"`
+	// Either "text" or "image". Defaults to "text" when creating a widget.
+	Kind string `json:"kind,omitempty"`
+	Text string `json:"text,omitempty"`
+	// For text buttons, the URL the button links to. For image buttons, the URL of the image.
+	URL string `json:"url,omitempty"`
+	// The URL an image button links to.
+	LinkURL   string `json:"linkUrl,omitempty"`
+	Width     int    `json:"width,omitempty"`
+	Height    int    `json:"height,omitempty"`
 	TextColor string `json:"textColor,omitempty"`
 	FillColor string `json:"fillColor,omitempty"`
 	// The color of the button's "outline".
@@ -408,6 +456,115 @@ func (r *CommunityListWidgetCreateRequest) MarshalJSON() ([]byte, error) {
 	}{r.requestKind(), r.Style, r.Name, r.Communities})
 }
 
+// ButtonWidgetCreateRequest represents a request to create a button widget.
+type ButtonWidgetCreateRequest struct {
+	Style *WidgetStyle `json:"styles,omitempty"`
+	// No longer than 30 characters.
+	Name string `json:"shortName,omitempty"`
+	// Raw markdown text.
+	Description string `json:"description,omitempty"`
+	// At most 10 buttons.
+	Buttons []*WidgetButton `json:"buttons,omitempty"`
+}
+
+func (*ButtonWidgetCreateRequest) requestKind() string { return widgetKindButton }
+
+// MarshalJSON implements the json.Marshaler interface.
+func (r *ButtonWidgetCreateRequest) MarshalJSON() ([]byte, error) {
+	buttons := make([]WidgetButton, 0, len(r.Buttons))
+	for _, b := range r.Buttons {
+		button := *b
+		if button.Kind == "" {
+			button.Kind = "text"
+		}
+		buttons = append(buttons, button)
+	}
+
+	return json.Marshal(struct {
+		Kind        string         `json:"kind"`
+		Style       *WidgetStyle   `json:"styles,omitempty"`
+		Name        string         `json:"shortName,omitempty"`
+		Description string         `json:"description,omitempty"`
+		Buttons     []WidgetButton `json:"buttons"`
+	}{r.requestKind(), r.Style, r.Name, r.Description, buttons})
+}
+
+// ImageWidgetCreateRequest represents a request to create an image widget.
+type ImageWidgetCreateRequest struct {
+	Style *WidgetStyle `json:"styles,omitempty"`
+	// No longer than 30 characters.
+	Name string `json:"shortName,omitempty"`
+	// At most 10 images. The URLs must point to images uploaded to Reddit.
+	Images []*WidgetImageLink `json:"data,omitempty"`
+}
+
+func (*ImageWidgetCreateRequest) requestKind() string { return widgetKindImage }
+
+// MarshalJSON implements the json.Marshaler interface.
+func (r *ImageWidgetCreateRequest) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Kind   string             `json:"kind"`
+		Style  *WidgetStyle       `json:"styles,omitempty"`
+		Name   string             `json:"shortName,omitempty"`
+		Images []*WidgetImageLink `json:"data"`
+	}{r.requestKind(), r.Style, r.Name, r.Images})
+}
+
+// CalendarWidgetCreateRequest represents a request to create a calendar widget.
+type CalendarWidgetCreateRequest struct {
+	Style *WidgetStyle `json:"styles,omitempty"`
+	// No longer than 30 characters.
+	Name string `json:"shortName,omitempty"`
+	// The ID of a public Google Calendar, e.g. abc123@group.calendar.google.com.
+	GoogleCalendarID string                       `json:"googleCalendarId,omitempty"`
+	RequiresSync     bool                         `json:"requiresSync"`
+	Configuration    *CalendarWidgetConfiguration `json:"configuration,omitempty"`
+}
+
+func (*CalendarWidgetCreateRequest) requestKind() string { return widgetKindCalendar }
+
+// MarshalJSON implements the json.Marshaler interface.
+func (r *CalendarWidgetCreateRequest) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Kind             string                       `json:"kind"`
+		Style            *WidgetStyle                 `json:"styles,omitempty"`
+		Name             string                       `json:"shortName,omitempty"`
+		GoogleCalendarID string                       `json:"googleCalendarId,omitempty"`
+		RequiresSync     bool                         `json:"requiresSync"`
+		Configuration    *CalendarWidgetConfiguration `json:"configuration,omitempty"`
+	}{r.requestKind(), r.Style, r.Name, r.GoogleCalendarID, r.RequiresSync, r.Configuration})
+}
+
+// CustomWidgetCreateRequest represents a request to create a custom widget.
+type CustomWidgetCreateRequest struct {
+	Style *WidgetStyle `json:"styles,omitempty"`
+	// No longer than 30 characters.
+	Name string `json:"shortName,omitempty"`
+	// Raw markdown text.
+	Text string `json:"text,omitempty"`
+	// CSS applied to the widget. No longer than 100000 characters.
+	StyleSheet string `json:"css,omitempty"`
+	// Between 50 and 500 pixels.
+	Height int `json:"height,omitempty"`
+	// Images that can be referenced in the stylesheet.
+	Images []*WidgetImage `json:"imageData,omitempty"`
+}
+
+func (*CustomWidgetCreateRequest) requestKind() string { return widgetKindCustom }
+
+// MarshalJSON implements the json.Marshaler interface.
+func (r *CustomWidgetCreateRequest) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Kind       string         `json:"kind"`
+		Style      *WidgetStyle   `json:"styles,omitempty"`
+		Name       string         `json:"shortName,omitempty"`
+		Text       string         `json:"text,omitempty"`
+		StyleSheet string         `json:"css,omitempty"`
+		Height     int            `json:"height,omitempty"`
+		Images     []*WidgetImage `json:"imageData,omitempty"`
+	}{r.requestKind(), r.Style, r.Name, r.Text, r.StyleSheet, r.Height, r.Images})
+}
+
 // Get the subreddit's widgets.
 func (s *WidgetService) Get(ctx context.Context, subreddit string) ([]Widget, *Response, error) {
 	path := fmt.Sprintf("r/%s/api/widgets?progressive_images=true", subreddit)
@@ -448,6 +605,28 @@ func (s *WidgetService) Create(ctx context.Context, subreddit string, request Wi
 	return root.Data, resp, nil
 }
 
+// Update a widget via its id.
+// The request replaces the widget entirely, so it must describe the widget in full.
+func (s *WidgetService) Update(ctx context.Context, subreddit, id string, request WidgetCreateRequest) (Widget, *Response, error) {
+	if request == nil {
+		return nil, nil, errors.New("WidgetCreateRequest: cannot be nil")
+	}
+
+	path := fmt.Sprintf("r/%s/api/widget/%s", subreddit, id)
+	req, err := s.client.NewJSONRequest(http.MethodPut, path, request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(rootWidget)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Data, resp, nil
+}
+
 // Delete a widget via its id.
 func (s *WidgetService) Delete(ctx context.Context, subreddit, id string) (*Response, error) {
 	path := fmt.Sprintf("r/%s/api/widget/%s", subreddit, id)
@@ -458,11 +637,11 @@ func (s *WidgetService) Delete(ctx context.Context, subreddit, id string) (*Resp
 	return s.client.Do(ctx, req, nil)
 }
 
-// Reorder the widgets in the subreddit.
-// The order should contain every single widget id in the subreddit; omitting any id will result in an error.
-// The id list should only contain sidebar widgets. It should exclude the community details and moderators widgets.
-func (s *WidgetService) Reorder(ctx context.Context, subreddit string, ids []string) (*Response, error) {
-	path := fmt.Sprintf("r/%s/api/widget_order/sidebar", subreddit)
+// Reorder the widgets of a section of the subreddit, e.g. "sidebar".
+// The order should contain every single widget id in the section; omitting any id will result in an error.
+// For the sidebar, it should exclude the community details and moderators widgets.
+func (s *WidgetService) Reorder(ctx context.Context, subreddit, section string, ids []string) (*Response, error) {
+	path := fmt.Sprintf("r/%s/api/widget_order/%s", subreddit, section)
 	req, err := s.client.NewJSONRequest(http.MethodPatch, path, ids)
 	if err != nil {
 		return nil, err
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -34,6 +35,7 @@ var expectedWidgets = []Widget{
 		Description: "test description",
 		Buttons: []*WidgetButton{
 			{
+				Kind:        "text",
 				Text:        "test text",
 				URL:         "https://example.com",
 				TextColor:   "#ff66ac",
@@ -60,6 +62,8 @@ var expectedWidgets = []Widget{
 			{
 				URL:     "https://www.redditstatic.com/image-processing.png",
 				LinkURL: "https://example.com",
+				Width:   64,
+				Height:  64,
 			},
 		},
 	},
@@ -164,10 +168,13 @@ var expectedWidgets = []Widget{
 		StyleSheetURL: "https://styles.redditmedia.com/t5_2uquw1/styles/customWidget-stylesheet-n2q86gjf04o51.css",
 		Images: []*WidgetImage{
 			{
-				Name: "test",
-				URL:  "https://www.redditstatic.com/image-processing.png",
+				Name:   "test",
+				URL:    "https://www.redditstatic.com/image-processing.png",
+				Width:  640,
+				Height: 192,
 			},
 		},
+		Height: 500,
 	},
 }
 
@@ -237,6 +244,166 @@ func TestWidgetService_Create(t *testing.T) {
 	}, createdWidget)
 }
 
+func TestWidgetService_Create_Button(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/r/testsubreddit/api/widget", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		body := new(struct {
+			Kind    string `json:"kind"`
+			Name    string `json:"shortName"`
+			Buttons []struct {
+				Kind    string `json:"kind"`
+				Text    string `json:"text"`
+				URL     string `json:"url"`
+				LinkURL string `json:"linkUrl"`
+			} `json:"buttons"`
+		})
+
+		err := json.NewDecoder(r.Body).Decode(body)
+		require.NoError(t, err)
+		require.Equal(t, "button", body.Kind)
+		require.Equal(t, "test name", body.Name)
+		require.Len(t, body.Buttons, 2)
+		require.Equal(t, "text", body.Buttons[0].Kind)
+		require.Equal(t, "test button", body.Buttons[0].Text)
+		require.Equal(t, "https://example.com", body.Buttons[0].URL)
+		require.Equal(t, "image", body.Buttons[1].Kind)
+		require.Equal(t, "https://i.redd.it/button.png", body.Buttons[1].URL)
+		require.Equal(t, "https://example.com", body.Buttons[1].LinkURL)
+
+		fmt.Fprint(w, `{
+			"kind": "button",
+			"shortName": "test name",
+			"buttons": [{"kind": "text", "text": "test button", "url": "https://example.com"}],
+			"id": "id123"
+		}`)
+	})
+
+	createdWidget, _, err := client.Widget.Create(ctx, "testsubreddit", &ButtonWidgetCreateRequest{
+		Name: "test name",
+		Buttons: []*WidgetButton{
+			{Text: "test button", URL: "https://example.com"},
+			{Kind: "image", Text: "image button", URL: "https://i.redd.it/button.png", LinkURL: "https://example.com"},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, &ButtonWidget{
+		widget: widget{
+			ID:   "id123",
+			Kind: "button",
+		},
+		Name:    "test name",
+		Buttons: []*WidgetButton{{Kind: "text", Text: "test button", URL: "https://example.com"}},
+	}, createdWidget)
+}
+
+func TestWidgetService_Create_Image(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/r/testsubreddit/api/widget", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		body := new(struct {
+			Kind   string             `json:"kind"`
+			Name   string             `json:"shortName"`
+			Images []*WidgetImageLink `json:"data"`
+		})
+
+		err := json.NewDecoder(r.Body).Decode(body)
+		require.NoError(t, err)
+		require.Equal(t, "image", body.Kind)
+		require.Equal(t, "test name", body.Name)
+		require.Equal(t, []*WidgetImageLink{
+			{URL: "https://i.redd.it/image.png", LinkURL: "https://example.com", Width: 64, Height: 32},
+		}, body.Images)
+
+		fmt.Fprint(w, `{
+			"kind": "image",
+			"shortName": "test name",
+			"data": [{"url": "https://i.redd.it/image.png", "linkUrl": "https://example.com", "width": 64, "height": 32}],
+			"id": "id123"
+		}`)
+	})
+
+	createdWidget, _, err := client.Widget.Create(ctx, "testsubreddit", &ImageWidgetCreateRequest{
+		Name: "test name",
+		Images: []*WidgetImageLink{
+			{URL: "https://i.redd.it/image.png", LinkURL: "https://example.com", Width: 64, Height: 32},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, &ImageWidget{
+		widget: widget{
+			ID:   "id123",
+			Kind: "image",
+		},
+		Name: "test name",
+		Images: []*WidgetImageLink{
+			{URL: "https://i.redd.it/image.png", LinkURL: "https://example.com", Width: 64, Height: 32},
+		},
+	}, createdWidget)
+}
+
+func TestWidgetService_Update(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/r/testsubreddit/api/widget/id123", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPut, r.Method)
+
+		body := new(struct {
+			Kind             string `json:"kind"`
+			GoogleCalendarID string `json:"googleCalendarId"`
+			Configuration    struct {
+				NumberOfEvents int `json:"numEvents"`
+			} `json:"configuration"`
+		})
+
+		err := json.NewDecoder(r.Body).Decode(body)
+		require.NoError(t, err)
+		require.Equal(t, "calendar", body.Kind)
+		require.Equal(t, "test@group.calendar.google.com", body.GoogleCalendarID)
+		require.Equal(t, 5, body.Configuration.NumberOfEvents)
+
+		fmt.Fprint(w, `{
+			"kind": "calendar",
+			"shortName": "events",
+			"googleCalendarId": "test@group.calendar.google.com",
+			"requiresSync": false,
+			"configuration": {"numEvents": 5, "showTitle": true},
+			"data": [{"title": "meetup", "startTime": 1604188800, "endTime": 1604192400, "allDay": false}],
+			"id": "id123"
+		}`)
+	})
+
+	_, _, err := client.Widget.Update(ctx, "testsubreddit", "id123", nil)
+	require.EqualError(t, err, "WidgetCreateRequest: cannot be nil")
+
+	updatedWidget, _, err := client.Widget.Update(ctx, "testsubreddit", "id123", &CalendarWidgetCreateRequest{
+		Name:             "events",
+		GoogleCalendarID: "test@group.calendar.google.com",
+		Configuration:    &CalendarWidgetConfiguration{NumberOfEvents: 5, ShowTitle: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, &CalendarWidget{
+		widget: widget{
+			ID:   "id123",
+			Kind: "calendar",
+		},
+		Name:             "events",
+		GoogleCalendarID: "test@group.calendar.google.com",
+		Configuration:    &CalendarWidgetConfiguration{NumberOfEvents: 5, ShowTitle: true},
+		Events: []*CalendarWidgetEvent{
+			{
+				Title: "meetup",
+				Start: &Timestamp{time.Date(2020, 11, 1, 0, 0, 0, 0, time.UTC)},
+				End:   &Timestamp{time.Date(2020, 11, 1, 1, 0, 0, 0, time.UTC)},
+			},
+		},
+	}, updatedWidget)
+}
+
 func TestWidgetService_Delete(t *testing.T) {
 	client, mux := setup(t)
 
@@ -260,6 +427,6 @@ func TestWidgetService_Reorder(t *testing.T) {
 		require.Equal(t, []string{"test1", "test2", "test3", "test4"}, ids)
 	})
 
-	_, err := client.Widget.Reorder(ctx, "testsubreddit", []string{"test1", "test2", "test3", "test4"})
+	_, err := client.Widget.Reorder(ctx, "testsubreddit", "sidebar", []string{"test1", "test2", "test3", "test4"})
 	require.NoError(t, err)
 }
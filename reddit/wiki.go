@@ -5,11 +5,14 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"html"
+	"io"
 	"net/http"
 	"net/url"
 	"strings"
 
 	"github.com/google/go-querystring/query"
+	"gopkg.in/yaml.v2"
 )
 
 // WikiService handles communication with the wiki
@@ -22,9 +25,11 @@ type WikiService struct {
 
 // WikiPage is a wiki page in a subreddit.
 type WikiPage struct {
-	Content   string `json:"content_md,omitempty"`
-	Reason    string `json:"reason,omitempty"`
-	MayRevise bool   `json:"may_revise"`
+	Content string `json:"content_md,omitempty"`
+	// The rendered HTML of the content.
+	ContentHTML string `json:"content_html,omitempty"`
+	Reason      string `json:"reason,omitempty"`
+	MayRevise   bool   `json:"may_revise"`
 
 	RevisionID   string     `json:"revision_id,omitempty"`
 	RevisionDate *Timestamp `json:"revision_date,omitempty"`
@@ -34,9 +39,10 @@ type WikiPage struct {
 // UnmarshalJSON implements the json.Unmarshaler interface.
 func (p *WikiPage) UnmarshalJSON(b []byte) error {
 	root := new(struct {
-		Content   string `json:"content_md,omitempty"`
-		Reason    string `json:"reason,omitempty"`
-		MayRevise bool   `json:"may_revise"`
+		Content     string `json:"content_md,omitempty"`
+		ContentHTML string `json:"content_html,omitempty"`
+		Reason      string `json:"reason,omitempty"`
+		MayRevise   bool   `json:"may_revise"`
 
 		RevisionID   string     `json:"revision_id,omitempty"`
 		RevisionDate *Timestamp `json:"revision_date,omitempty"`
@@ -49,6 +55,7 @@ func (p *WikiPage) UnmarshalJSON(b []byte) error {
 	}
 
 	p.Content = root.Content
+	p.ContentHTML = html.UnescapeString(root.ContentHTML)
 	p.Reason = root.Reason
 	p.MayRevise = root.MayRevise
 
@@ -69,6 +76,9 @@ type WikiPageEditRequest struct {
 	Content   string `url:"content"`
 	// Optional, up to 256 characters long.
 	Reason string `url:"reason,omitempty"`
+	// Optional, the ID of the revision the edit is based on.
+	// If the page has been revised since, the edit fails with a *WikiEditConflictError.
+	PreviousRevisionID string `url:"previous,omitempty"`
 }
 
 // WikiPagePermissionLevel defines who can edit a specific wiki page in a subreddit.
@@ -394,3 +404,62 @@ func (s *WikiService) Deny(ctx context.Context, subreddit, page, username string
 
 	return s.client.Do(ctx, req, nil)
 }
+
+// The wiki page holding the subreddit's AutoModerator rules.
+const autoModeratorConfigPage = "config/automoderator"
+
+// AutoModeratorConfig gets the subreddit's AutoModerator configuration, i.e.
+// the YAML content of its config/automoderator wiki page.
+func (s *WikiService) AutoModeratorConfig(ctx context.Context, subreddit string) (string, *Response, error) {
+	page, resp, err := s.Page(ctx, subreddit, autoModeratorConfigPage)
+	if err != nil {
+		return "", resp, err
+	}
+	// Reddit escapes the markdown of wiki pages, which would break the YAML.
+	return html.UnescapeString(page.Content), resp, nil
+}
+
+// UpdateAutoModeratorConfig validates the AutoModerator configuration locally via ValidateAutoModeratorConfig,
+// and if it is valid, replaces the subreddit's configuration with it.
+// If Reddit rejects the configuration, the error will be a *WikiSpecialPageError listing the problems.
+// previousRevisionID is optional: when set to the revision the configuration was read from (i.e. the
+// RevisionID of the "config/automoderator" page), the update fails with a *WikiEditConflictError
+// if the configuration has been edited since.
+func (s *WikiService) UpdateAutoModeratorConfig(ctx context.Context, subreddit, config, reason, previousRevisionID string) (*Response, error) {
+	err := ValidateAutoModeratorConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Edit(ctx, &WikiPageEditRequest{
+		Subreddit: subreddit,
+		Page:      autoModeratorConfigPage,
+		Content:   config,
+		Reason:    reason,
+
+		PreviousRevisionID: previousRevisionID,
+	})
+}
+
+// ValidateAutoModeratorConfig checks that the AutoModerator configuration is valid YAML, made up of
+// rules separated by "---", each of which is a mapping. It doesn't check the rules themselves,
+// which is left to Reddit.
+func ValidateAutoModeratorConfig(config string) error {
+	decoder := yaml.NewDecoder(strings.NewReader(config))
+	for i := 1; ; i++ {
+		var rule interface{}
+		err := decoder.Decode(&rule)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("rule %d: %w", i, err)
+		}
+
+		switch rule.(type) {
+		case nil, map[interface{}]interface{}:
+		default:
+			return fmt.Errorf("rule %d: must be a mapping of fields to values", i)
+		}
+	}
+}
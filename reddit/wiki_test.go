@@ -11,9 +11,10 @@ import (
 )
 
 var expectedWikiPage = &WikiPage{
-	Content:   "test reason",
-	Reason:    "this is a reason!",
-	MayRevise: true,
+	Content:     "test reason",
+	ContentHTML: "<!-- SC_OFF --><div class=\"md wiki\"><p>test reason</p>\n</div><!-- SC_ON -->",
+	Reason:      "this is a reason!",
+	MayRevise:   true,
 
 	RevisionID:   "3c4e9fab-ef2c-11ea-90b6-0e9189256887",
 	RevisionDate: &Timestamp{time.Date(2020, 9, 5, 3, 59, 45, 0, time.UTC)},
@@ -53,7 +54,7 @@ var expectedWikiPageDiscussions = []*Post{
 		ID:      "imj8g5",
 		FullID:  "t3_imj8g5",
 		Created: &Timestamp{time.Date(2020, 9, 4, 16, 33, 33, 0, time.UTC)},
-		Edited:  &Timestamp{time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)},
+		Edited:  &Edited{},
 
 		Permalink: "/r/helloworldtestt/comments/imj8g5/test/",
 		URL:       "https://www.reddit.com/r/helloworldtestt/wiki/index",
@@ -73,6 +74,12 @@ var expectedWikiPageDiscussions = []*Post{
 
 		Author:   "v_95",
 		AuthorID: "t2_164ab8",
+		AuthorFlairRichText: FlairRichText{
+			{Type: "text", Text: "test "},
+			{Type: "emoji", Emoji: ":karma:", URL: "https://emoji.redditmedia.com/dgnf69ls1guz_t5_3nqvj/karma"},
+		},
+
+		AllAwardings: []*Award{},
 	},
 }
 
@@ -202,6 +209,46 @@ func TestWikiService_Edit(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestWikiService_Edit_Conflict(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/r/testsubreddit/api/wiki/edit", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("page", "testpage")
+		form.Set("content", "testcontent")
+		form.Set("previous", "oldrevision")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+
+		w.WriteHeader(http.StatusConflict)
+		fmt.Fprint(w, `{
+			"reason": "EDIT_CONFLICT",
+			"message": "Conflict",
+			"newcontent": "newcontent",
+			"newrevision": "newrevision",
+			"diffcontent": "<ins>newcontent</ins>"
+		}`)
+	})
+
+	_, err := client.Wiki.Edit(ctx, &WikiPageEditRequest{
+		Subreddit:          "testsubreddit",
+		Page:               "testpage",
+		Content:            "testcontent",
+		PreviousRevisionID: "oldrevision",
+	})
+	require.IsType(t, &WikiEditConflictError{}, err)
+
+	conflictErr := err.(*WikiEditConflictError)
+	require.Equal(t, "Conflict", conflictErr.Message)
+	require.Equal(t, "newcontent", conflictErr.NewContent)
+	require.Equal(t, "newrevision", conflictErr.NewRevisionID)
+	require.Equal(t, "<ins>newcontent</ins>", conflictErr.Diff)
+}
+
 func TestWikiService_Revert(t *testing.T) {
 	client, mux := setup(t)
 
@@ -389,3 +436,66 @@ func TestWikiService_Deny(t *testing.T) {
 	_, err := client.Wiki.Deny(ctx, "testsubreddit", "testpage", "testusername")
 	require.NoError(t, err)
 }
+
+func TestWikiService_AutoModeratorConfig(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/r/testsubreddit/wiki/config/automoderator", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, `{
+			"kind": "wikipage",
+			"data": {
+				"content_md": "type: comment\nbody (includes): [\"spam\"]\naction: remove\n---\ntitle (regex): \"^&gt;\"\naction: filter",
+				"may_revise": true
+			}
+		}`)
+	})
+
+	config, _, err := client.Wiki.AutoModeratorConfig(ctx, "testsubreddit")
+	require.NoError(t, err)
+	require.Equal(t, "type: comment\nbody (includes): [\"spam\"]\naction: remove\n---\ntitle (regex): \"^>\"\naction: filter", config)
+}
+
+func TestWikiService_UpdateAutoModeratorConfig(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/r/testsubreddit/api/wiki/edit", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("page", "config/automoderator")
+		form.Set("content", "type: comment\naction: remov")
+		form.Set("reason", "testreason")
+		form.Set("previous", "testrevision")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+
+		w.WriteHeader(http.StatusUnsupportedMediaType)
+		fmt.Fprint(w, `{
+			"reason": "SPECIAL_ERRORS",
+			"message": "Unsupported Media Type",
+			"special_errors": ["invalid value for `+"`action`"+`: remov"]
+		}`)
+	})
+
+	_, err := client.Wiki.UpdateAutoModeratorConfig(ctx, "testsubreddit", "type: comment\n---\n- action", "testreason", "")
+	require.EqualError(t, err, "rule 2: must be a mapping of fields to values")
+
+	_, err = client.Wiki.UpdateAutoModeratorConfig(ctx, "testsubreddit", "type: comment\naction: remov", "testreason", "testrevision")
+	require.IsType(t, &WikiSpecialPageError{}, err)
+	require.Equal(t, []string{"invalid value for `action`: remov"}, err.(*WikiSpecialPageError).Errors)
+}
+
+func TestValidateAutoModeratorConfig(t *testing.T) {
+	require.NoError(t, ValidateAutoModeratorConfig(""))
+	require.NoError(t, ValidateAutoModeratorConfig("type: comment\naction: remove\n---\n# comment only\n---\ntype: submission\naction: approve\n"))
+
+	err := ValidateAutoModeratorConfig("type: comment\n---\naction: [remove\n")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "rule 2: yaml:")
+
+	err = ValidateAutoModeratorConfig("just a string")
+	require.EqualError(t, err, "rule 1: must be a mapping of fields to values")
+}